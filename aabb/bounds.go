@@ -1,15 +1,14 @@
 package aabb
 
 import "github.com/oniproject/math/f"
+import gaabb "github.com/oniproject/math/generic/aabb"
 
-type Bounds struct {
-	Min, Max f.Float
-}
+type Bounds = gaabb.Bounds[f.Float]
 
 func BoundsOverlap(a, b Bounds) bool {
-	return (a.Min <= b.Max && b.Min <= a.Max)
+	return gaabb.BoundsOverlap(a, b)
 }
 
 func ToBounds(bb AABB) Bounds {
-	return Bounds{bb.L, bb.R}
+	return gaabb.ToBounds(bb)
 }
@@ -0,0 +1,90 @@
+// Operations over whole arrays of vectors, as slices of v.Vect or as a
+// structure of arrays (SoA) with separate x and y slices.
+//
+// Every function writes its results to dst, which may alias a source.
+// The sources are resliced to the length of dst up front, so a too short
+// source panics before anything is written and the loops run without
+// bounds checks. The SoA layout keeps each component contiguous, which
+// suits vector units best.
+package batch
+
+import "github.com/oniproject/math/f"
+import "github.com/oniproject/math/v"
+import "github.com/oniproject/math/aabb"
+import "github.com/oniproject/math/t"
+
+// Transform the points src by m into dst. (translation included)
+// Uses SIMD instructions where available.
+func TransformPoints(dst, src []v.Vect, m t.Transform) {
+	active.transformPoints(dst, src[:len(dst)], &m)
+}
+
+// Transform the vectors src by m into dst. (translation ignored)
+func TransformVects(dst, src []v.Vect, m t.Transform) {
+	src = src[:len(dst)]
+	for i := range dst {
+		p := src[i]
+		dst[i] = v.V(f.Float(m.A*p.X)+f.Float(m.C*p.Y), f.Float(m.B*p.X)+f.Float(m.D*p.Y))
+	}
+}
+
+// Add a and b element wise into dst.
+func Add(dst, a, b []v.Vect) {
+	a, b = a[:len(dst)], b[:len(dst)]
+	for i := range dst {
+		dst[i] = v.V(a[i].X+b[i].X, a[i].Y+b[i].Y)
+	}
+}
+
+// Add the offset d to every vector of src into dst.
+func Offset(dst, src []v.Vect, d v.Vect) {
+	src = src[:len(dst)]
+	for i := range dst {
+		dst[i] = v.V(src[i].X+d.X, src[i].Y+d.Y)
+	}
+}
+
+// Multiply every vector of src by s into dst.
+func Scale(dst, src []v.Vect, s f.Float) {
+	src = src[:len(dst)]
+	for i := range dst {
+		dst[i] = v.V(src[i].X*s, src[i].Y*s)
+	}
+}
+
+// Normalize every vector of src into dst, with the same results as v.Normalize.
+// Uses SIMD instructions where available.
+func Normalize(dst, src []v.Vect) {
+	active.normalize(dst, src[:len(dst)])
+}
+
+// Dot products of a and b element wise into dst.
+func Dot(dst []f.Float, a, b []v.Vect) {
+	a, b = a[:len(dst)], b[:len(dst)]
+	for i := range dst {
+		dst[i] = f.Float(a[i].X*b[i].X) + f.Float(a[i].Y*b[i].Y)
+	}
+}
+
+// Returns the bounding box of the points.
+// An empty slice gives an inverted box from +Inf to -Inf that aabb.Merge ignores.
+func BB(points []v.Vect) aabb.AABB {
+	bb := aabb.New(f.Inf, f.Inf, -f.Inf, -f.Inf)
+	for _, p := range points {
+		bb.L = f.Min(bb.L, p.X)
+		bb.B = f.Min(bb.B, p.Y)
+		bb.R = f.Max(bb.R, p.X)
+		bb.T = f.Max(bb.T, p.Y)
+	}
+	return bb
+}
+
+// Returns a bounding box that holds all the boxes.
+// Uses SIMD instructions where available. NaN coordinates are skipped
+// on every path, where merging with aabb.Merge would propagate them.
+// An empty slice gives an inverted box from +Inf to -Inf that aabb.Merge ignores.
+func MergeAll(boxes []aabb.AABB) aabb.AABB {
+	bb := aabb.New(f.Inf, f.Inf, -f.Inf, -f.Inf)
+	active.merge(boxes, &bb)
+	return bb
+}
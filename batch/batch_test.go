@@ -0,0 +1,221 @@
+package batch
+
+import (
+	"github.com/oniproject/math/aabb"
+	"github.com/oniproject/math/f"
+	"github.com/oniproject/math/t"
+	"github.com/oniproject/math/v"
+	. "github.com/smartystreets/goconvey/convey"
+	"math/rand"
+	"testing"
+)
+
+func randomPoints(n int) []v.Vect {
+	rng := rand.New(rand.NewSource(1))
+	points := make([]v.Vect, n)
+	for i := range points {
+		points[i] = v.V(f.Float(rng.Float64()*200-100), f.Float(rng.Float64()*200-100))
+	}
+	return points
+}
+
+func TestBatch(test *testing.T) {
+	m := t.Mult(t.Translate(v.V(3, -2)), t.Mult(t.Rotate(0.7), t.Scale(2, 0.5)))
+	src := randomPoints(100)
+	src[7] = v.Zero()
+
+	Convey("Array of structs", test, func() {
+		dst := make([]v.Vect, len(src))
+		Convey("TransformPoints", func() {
+			TransformPoints(dst, src, m)
+			for i := range src {
+				So(dst[i], ShouldResemble, m.Point(src[i]))
+			}
+		})
+		Convey("TransformVects", func() {
+			TransformVects(dst, src, m)
+			for i := range src {
+				So(dst[i], ShouldResemble, m.Vect(src[i]))
+			}
+		})
+		Convey("Add, Offset and Scale", func() {
+			Add(dst, src, src)
+			for i := range src {
+				So(dst[i], ShouldResemble, v.Add(src[i], src[i]))
+			}
+			Offset(dst, src, v.V(1, 2))
+			for i := range src {
+				So(dst[i], ShouldResemble, v.Add(src[i], v.V(1, 2)))
+			}
+			Scale(dst, src, 3)
+			for i := range src {
+				So(dst[i], ShouldResemble, v.Mult(src[i], 3))
+			}
+		})
+		Convey("Normalize", func() {
+			Normalize(dst, src)
+			for i := range src {
+				So(dst[i], ShouldResemble, v.Normalize(src[i]))
+			}
+			So(dst[7], ShouldResemble, v.Zero())
+		})
+		Convey("Dot", func() {
+			dots := make([]f.Float, len(src))
+			Dot(dots, src, src)
+			for i := range src {
+				So(dots[i], ShouldEqual, v.Dot(src[i], src[i]))
+			}
+		})
+		Convey("BB", func() {
+			bb := BB(src)
+			want := aabb.New(src[0].X, src[0].Y, src[0].X, src[0].Y)
+			for _, p := range src {
+				want = aabb.Expand(want, p)
+			}
+			So(bb, ShouldResemble, want)
+			So(aabb.Merge(BB(nil), want), ShouldResemble, want)
+		})
+		Convey("Aliasing and lengths", func() {
+			copy(dst, src)
+			Scale(dst, dst, 2)
+			So(dst[3], ShouldResemble, v.Mult(src[3], 2))
+
+			// Only len(dst) elements are processed.
+			short := make([]v.Vect, 3)
+			Scale(short, src, 2)
+			So(short[2], ShouldResemble, v.Mult(src[2], 2))
+			So(func() { Scale(dst, short, 2) }, ShouldPanic)
+		})
+	})
+
+	Convey("Structure of arrays", test, func() {
+		s := NewSoA(len(src))
+		ToSoA(s, src)
+		So(s.Len(), ShouldEqual, len(src))
+		So(s.At(5), ShouldResemble, src[5])
+
+		dst := NewSoA(len(src))
+		back := make([]v.Vect, len(src))
+		Convey("Round trip", func() {
+			FromSoA(back, s)
+			So(back, ShouldResemble, src)
+		})
+		Convey("TransformPointsSoA", func() {
+			TransformPointsSoA(dst, s, m)
+			for i := range src {
+				So(dst.At(i), ShouldResemble, m.Point(src[i]))
+			}
+		})
+		Convey("TransformVectsSoA", func() {
+			TransformVectsSoA(dst, s, m)
+			for i := range src {
+				So(dst.At(i), ShouldResemble, m.Vect(src[i]))
+			}
+		})
+		Convey("AddSoA, OffsetSoA and ScaleSoA", func() {
+			AddSoA(dst, s, s)
+			So(dst.At(9), ShouldResemble, v.Add(src[9], src[9]))
+			OffsetSoA(dst, s, v.V(1, 2))
+			So(dst.At(9), ShouldResemble, v.Add(src[9], v.V(1, 2)))
+			ScaleSoA(dst, s, 3)
+			So(dst.At(9), ShouldResemble, v.Mult(src[9], 3))
+		})
+		Convey("NormalizeSoA", func() {
+			NormalizeSoA(dst, s)
+			for i := range src {
+				So(dst.At(i), ShouldResemble, v.Normalize(src[i]))
+			}
+		})
+		Convey("DotSoA", func() {
+			dots := make([]f.Float, len(src))
+			DotSoA(dots, s, s)
+			So(dots[4], ShouldEqual, v.Dot(src[4], src[4]))
+		})
+		Convey("BBSoA", func() {
+			So(BBSoA(s), ShouldResemble, BB(src))
+			So(BBSoA(SoA{}), ShouldResemble, BB(nil))
+		})
+	})
+}
+
+const benchN = 10000
+
+func BenchmarkTransformPointsLoop(b *testing.B) {
+	m := t.Rigid(v.V(3, 4), 0.5)
+	src := randomPoints(benchN)
+	dst := make([]v.Vect, benchN)
+	b.SetBytes(benchN * 2 * f.Bits / 8)
+	for n := 0; n < b.N; n++ {
+		for i, p := range src {
+			dst[i] = m.Point(p)
+		}
+	}
+}
+
+func BenchmarkTransformPoints(b *testing.B) {
+	m := t.Rigid(v.V(3, 4), 0.5)
+	src := randomPoints(benchN)
+	dst := make([]v.Vect, benchN)
+	b.SetBytes(benchN * 2 * f.Bits / 8)
+	for n := 0; n < b.N; n++ {
+		TransformPoints(dst, src, m)
+	}
+}
+
+func BenchmarkTransformPointsSoA(b *testing.B) {
+	m := t.Rigid(v.V(3, 4), 0.5)
+	src := NewSoA(benchN)
+	ToSoA(src, randomPoints(benchN))
+	dst := NewSoA(benchN)
+	b.SetBytes(benchN * 2 * f.Bits / 8)
+	for n := 0; n < b.N; n++ {
+		TransformPointsSoA(dst, src, m)
+	}
+}
+
+func BenchmarkNormalizeLoop(b *testing.B) {
+	src := randomPoints(benchN)
+	dst := make([]v.Vect, benchN)
+	b.SetBytes(benchN * 2 * f.Bits / 8)
+	for n := 0; n < b.N; n++ {
+		for i, p := range src {
+			dst[i] = v.Normalize(p)
+		}
+	}
+}
+
+func BenchmarkNormalize(b *testing.B) {
+	src := randomPoints(benchN)
+	dst := make([]v.Vect, benchN)
+	b.SetBytes(benchN * 2 * f.Bits / 8)
+	for n := 0; n < b.N; n++ {
+		Normalize(dst, src)
+	}
+}
+
+func BenchmarkNormalizeSoA(b *testing.B) {
+	src := NewSoA(benchN)
+	ToSoA(src, randomPoints(benchN))
+	dst := NewSoA(benchN)
+	b.SetBytes(benchN * 2 * f.Bits / 8)
+	for n := 0; n < b.N; n++ {
+		NormalizeSoA(dst, src)
+	}
+}
+
+func BenchmarkBB(b *testing.B) {
+	src := randomPoints(benchN)
+	b.SetBytes(benchN * 2 * f.Bits / 8)
+	for n := 0; n < b.N; n++ {
+		BB(src)
+	}
+}
+
+func BenchmarkBBSoA(b *testing.B) {
+	src := NewSoA(benchN)
+	ToSoA(src, randomPoints(benchN))
+	b.SetBytes(benchN * 2 * f.Bits / 8)
+	for n := 0; n < b.N; n++ {
+		BBSoA(src)
+	}
+}
@@ -0,0 +1,69 @@
+package batch
+
+import "github.com/oniproject/math/f"
+import "github.com/oniproject/math/v"
+import "github.com/oniproject/math/aabb"
+import "github.com/oniproject/math/t"
+
+// One implementation of each hot loop.
+// The sources are already resliced to the length of dst.
+type kernels struct {
+	name            string
+	transformPoints func(dst, src []v.Vect, m *t.Transform)
+	normalize       func(dst, src []v.Vect)
+	merge           func(boxes []aabb.AABB, acc *aabb.AABB)
+}
+
+// Pure Go kernels, used wherever no SIMD version exists.
+var genericKernels = kernels{"generic", transformPointsGeneric, normalizeGeneric, mergeGeneric}
+
+// Kernels supported by this CPU, from the slowest to the fastest.
+// The SIMD versions register themselves at init.
+var available = []kernels{genericKernels}
+
+// Kernels used by the exported functions.
+var active = genericKernels
+
+// Products in the pure Go kernels are converted with f.Float() so the
+// compiler cannot fuse them into FMA. They round every operation like the
+// SIMD kernels, t.Transform.Point and v.Normalize on every GOAMD64 level.
+
+func transformPointsGeneric(dst, src []v.Vect, m *t.Transform) {
+	src = src[:len(dst)]
+	for i := range dst {
+		p := src[i]
+		dst[i] = v.V(f.Float(m.A*p.X)+f.Float(m.C*p.Y)+m.Tx, f.Float(m.B*p.X)+f.Float(m.D*p.Y)+m.Ty)
+	}
+}
+
+func normalizeGeneric(dst, src []v.Vect) {
+	src = src[:len(dst)]
+	for i := range dst {
+		p := src[i]
+		s := 1.0 / (f.Sqrt(f.Float(p.X*p.X)+f.Float(p.Y*p.Y)) + f.FloatMin)
+		dst[i] = v.V(p.X*s, p.Y*s)
+	}
+}
+
+// Merge the boxes into acc, with the same results as aabb.Merge except
+// that NaN coordinates are skipped. The comparisons are written so that
+// the order of the boxes does not matter up to the sign of zero, which lets the SIMD kernels
+// merge several boxes side by side.
+func mergeGeneric(boxes []aabb.AABB, acc *aabb.AABB) {
+	bb := *acc
+	for _, b := range boxes {
+		if b.L < bb.L {
+			bb.L = b.L
+		}
+		if b.B < bb.B {
+			bb.B = b.B
+		}
+		if b.R > bb.R {
+			bb.R = b.R
+		}
+		if b.T > bb.T {
+			bb.T = b.T
+		}
+	}
+	*acc = bb
+}
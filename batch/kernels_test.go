@@ -0,0 +1,193 @@
+package batch
+
+import (
+	"encoding/binary"
+	"github.com/oniproject/math/aabb"
+	"github.com/oniproject/math/f"
+	"github.com/oniproject/math/t"
+	"github.com/oniproject/math/v"
+	. "github.com/smartystreets/goconvey/convey"
+	"math"
+	"testing"
+)
+
+// Decode fuzz input into floats, keeping their magnitudes within
+// [1e-15, 1e15] or zero so that squares neither overflow nor underflow.
+// NaN is kept, the kernels must handle it like the pure Go ones.
+func fuzzFloats(data []byte) []f.Float {
+	xs := make([]f.Float, len(data)/4)
+	for i := range xs {
+		x := math.Float32frombits(binary.LittleEndian.Uint32(data[4*i:]))
+		switch {
+		case x != x:
+		case math.Abs(float64(x)) < 1e-15:
+			x = 0
+		case x > 1e15:
+			x = 1e15
+		case x < -1e15:
+			x = -1e15
+		}
+		xs[i] = f.Float(x)
+	}
+	return xs
+}
+
+func fuzzPoints(data []byte) []v.Vect {
+	xs := fuzzFloats(data)
+	points := make([]v.Vect, len(xs)/2)
+	for i := range points {
+		points[i] = v.V(xs[2*i], xs[2*i+1])
+	}
+	return points
+}
+
+// Returns true if got matches want bit for bit, where any NaN matches any NaN.
+// The pure Go kernels cannot be fused into FMA, so every kernel rounds alike.
+func sameFloat(got, want f.Float) bool {
+	if got != got || want != want {
+		return got != got && want != want
+	}
+	return math.Float64bits(float64(got)) == math.Float64bits(float64(want))
+}
+
+func checkTransformPoints(test *testing.T, src []v.Vect, m t.Transform) {
+	want := make([]v.Vect, len(src))
+	transformPointsGeneric(want, src, &m)
+	for _, k := range available {
+		got := make([]v.Vect, len(src))
+		k.transformPoints(got, src, &m)
+		for i, p := range src {
+			if !sameFloat(got[i].X, want[i].X) || !sameFloat(got[i].Y, want[i].Y) {
+				test.Fatalf("%s: transform of %v by %v is %v, want %v", k.name, p, m, got[i], want[i])
+			}
+		}
+	}
+}
+
+func checkNormalize(test *testing.T, src []v.Vect) {
+	want := make([]v.Vect, len(src))
+	normalizeGeneric(want, src)
+	for _, k := range available {
+		got := make([]v.Vect, len(src))
+		k.normalize(got, src)
+		for i, p := range src {
+			if !sameFloat(got[i].X, want[i].X) || !sameFloat(got[i].Y, want[i].Y) {
+				test.Fatalf("%s: normalized %v is %v, want %v", k.name, p, got[i], want[i])
+			}
+		}
+	}
+}
+
+func checkMerge(test *testing.T, boxes []aabb.AABB) {
+	want := aabb.New(f.Inf, f.Inf, -f.Inf, -f.Inf)
+	mergeGeneric(boxes, &want)
+	for _, k := range available {
+		got := aabb.New(f.Inf, f.Inf, -f.Inf, -f.Inf)
+		k.merge(boxes, &got)
+		if !aabb.ApproxEqual(got, want, 0, 0) {
+			test.Fatalf("%s: merged %d boxes into %v, want %v", k.name, len(boxes), got, want)
+		}
+	}
+}
+
+func FuzzTransformPoints(fz *testing.F) {
+	fz.Add([]byte{0, 0, 128, 63, 0, 0, 0, 64, 0, 0, 64, 64}, float32(1), float32(0), float32(0), float32(1), float32(2), float32(3))
+	fz.Add(make([]byte, 76), float32(0.5), float32(-2), float32(3), float32(1e3), float32(-7), float32(1e-3))
+	fz.Fuzz(func(test *testing.T, data []byte, a, b, c, d, tx, ty float32) {
+		coef := make([]byte, 0, 24)
+		for _, x := range []float32{a, b, c, d, tx, ty} {
+			coef = binary.LittleEndian.AppendUint32(coef, math.Float32bits(x))
+		}
+		k := fuzzFloats(coef)
+		checkTransformPoints(test, fuzzPoints(data), t.New(k[0], k[1], k[2], k[3], k[4], k[5]))
+	})
+}
+
+func FuzzNormalize(fz *testing.F) {
+	fz.Add([]byte{0, 0, 128, 63, 0, 0, 0, 64, 0, 0, 64, 64})
+	fz.Add(make([]byte, 76))
+	fz.Fuzz(func(test *testing.T, data []byte) {
+		checkNormalize(test, fuzzPoints(data))
+	})
+}
+
+func FuzzMergeAll(fz *testing.F) {
+	fz.Add([]byte{0, 0, 128, 63, 0, 0, 0, 64, 0, 0, 64, 64, 0, 0, 128, 64})
+	fz.Add(make([]byte, 80))
+	fz.Fuzz(func(test *testing.T, data []byte) {
+		xs := fuzzFloats(data)
+		boxes := make([]aabb.AABB, len(xs)/4)
+		for i := range boxes {
+			boxes[i] = aabb.New(xs[4*i], xs[4*i+1], xs[4*i+2], xs[4*i+3])
+		}
+		checkMerge(test, boxes)
+	})
+}
+
+func TestKernels(test *testing.T) {
+	Convey("SIMD kernels", test, func() {
+		So(active.name, ShouldEqual, available[len(available)-1].name)
+		test.Logf("kernels: %d available, using %s", len(available), active.name)
+
+		// Every length up to a few blocks, to cover the tails.
+		src := randomPoints(19)
+		m := t.Mult(t.Translate(v.V(3, -2)), t.Mult(t.Rotate(0.7), t.Scale(2, 0.5)))
+		boxes := make([]aabb.AABB, len(src))
+		for i, p := range src {
+			boxes[i] = aabb.ForCircle(p, f.Float(i))
+		}
+		for n := 0; n <= len(src); n++ {
+			checkTransformPoints(test, src[:n], m)
+			checkNormalize(test, src[:n])
+			checkMerge(test, boxes[:n])
+		}
+
+		want := BB(nil)
+		for _, bb := range boxes {
+			want = aabb.Merge(want, bb)
+		}
+		So(MergeAll(boxes), ShouldResemble, want)
+		So(MergeAll(nil), ShouldResemble, BB(nil))
+
+		// NaN coordinates are skipped on every path.
+		nan := f.Float(math.NaN())
+		withNaN := append([]aabb.AABB{aabb.New(nan, nan, nan, nan)}, boxes...)
+		withNaN = append(withNaN, aabb.New(nan, -1000, nan, 1000))
+		want.B, want.T = -1000, 1000
+		for n := 0; n <= len(withNaN); n++ {
+			checkMerge(test, withNaN[:n])
+		}
+		So(MergeAll(withNaN), ShouldResemble, want)
+	})
+}
+
+func BenchmarkKernels(b *testing.B) {
+	m := t.Rigid(v.V(3, 4), 0.5)
+	src := randomPoints(benchN)
+	dst := make([]v.Vect, benchN)
+	boxes := make([]aabb.AABB, benchN)
+	for i, p := range src {
+		boxes[i] = aabb.ForCircle(p, 1)
+	}
+	for _, k := range available {
+		b.Run("TransformPoints/"+k.name, func(b *testing.B) {
+			b.SetBytes(benchN * 2 * f.Bits / 8)
+			for n := 0; n < b.N; n++ {
+				k.transformPoints(dst, src, &m)
+			}
+		})
+		b.Run("Normalize/"+k.name, func(b *testing.B) {
+			b.SetBytes(benchN * 2 * f.Bits / 8)
+			for n := 0; n < b.N; n++ {
+				k.normalize(dst, src)
+			}
+		})
+		b.Run("MergeAll/"+k.name, func(b *testing.B) {
+			b.SetBytes(benchN * 4 * f.Bits / 8)
+			for n := 0; n < b.N; n++ {
+				bb := aabb.New(f.Inf, f.Inf, -f.Inf, -f.Inf)
+				k.merge(boxes, &bb)
+			}
+		})
+	}
+}
@@ -0,0 +1,79 @@
+//go:build !f64 && !purego
+// +build !f64,!purego
+
+package batch
+
+import "golang.org/x/sys/cpu"
+import "github.com/oniproject/math/v"
+import "github.com/oniproject/math/aabb"
+import "github.com/oniproject/math/t"
+
+// SSE2 is part of amd64, AVX is detected at runtime.
+// Neither uses FMA so the results match the pure Go kernels bit for bit.
+//
+// The 256-bit kernels only need AVX: they are all float arithmetic, and
+// their broadcasts load from memory. AVX2 adds integer lanes and register
+// broadcasts, which none of them would use. Requiring it would only drop
+// the CPUs that have AVX without AVX2.
+func init() {
+	available = append(available, kernels{"sse", transformPointsSSE, normalizeSSE, mergeSSE})
+	if cpu.X86.HasAVX {
+		available = append(available, kernels{"avx", transformPointsAVX, normalizeAVX, mergeAVX})
+	}
+	active = available[len(available)-1]
+}
+
+// The assembly kernels process whole blocks of 2 (SSE) or 4 (AVX) vectors,
+// the wrappers below finish the rest in Go.
+
+//go:noescape
+func transformPointsSSE2(dst, src []v.Vect, m *t.Transform)
+
+//go:noescape
+func transformPointsAVX4(dst, src []v.Vect, m *t.Transform)
+
+//go:noescape
+func normalizeSSE2(dst, src []v.Vect)
+
+//go:noescape
+func normalizeAVX4(dst, src []v.Vect)
+
+//go:noescape
+func mergeSSE1(boxes []aabb.AABB, acc *aabb.AABB)
+
+//go:noescape
+func mergeAVX2(boxes []aabb.AABB, acc *aabb.AABB)
+
+func transformPointsSSE(dst, src []v.Vect, m *t.Transform) {
+	n := len(dst) &^ 1
+	transformPointsSSE2(dst[:n], src[:n], m)
+	transformPointsGeneric(dst[n:], src[n:], m)
+}
+
+func transformPointsAVX(dst, src []v.Vect, m *t.Transform) {
+	n := len(dst) &^ 3
+	transformPointsAVX4(dst[:n], src[:n], m)
+	transformPointsGeneric(dst[n:], src[n:], m)
+}
+
+func normalizeSSE(dst, src []v.Vect) {
+	n := len(dst) &^ 1
+	normalizeSSE2(dst[:n], src[:n])
+	normalizeGeneric(dst[n:], src[n:])
+}
+
+func normalizeAVX(dst, src []v.Vect) {
+	n := len(dst) &^ 3
+	normalizeAVX4(dst[:n], src[:n])
+	normalizeGeneric(dst[n:], src[n:])
+}
+
+func mergeSSE(boxes []aabb.AABB, acc *aabb.AABB) {
+	mergeSSE1(boxes, acc)
+}
+
+func mergeAVX(boxes []aabb.AABB, acc *aabb.AABB) {
+	n := len(boxes) &^ 1
+	mergeAVX2(boxes[:n], acc)
+	mergeGeneric(boxes[n:], acc)
+}
@@ -0,0 +1,207 @@
+//go:build !f64 && !purego
+// +build !f64,!purego
+
+#include "textflag.h"
+
+DATA one<>+0(SB)/4, $0x3f800000
+GLOBL one<>(SB), RODATA|NOPTR, $4
+
+// Smallest positive normal float32, see f.FloatMin.
+DATA floatMin<>+0(SB)/4, $0x00800000
+GLOBL floatMin<>(SB), RODATA|NOPTR, $4
+
+// func transformPointsSSE2(dst, src []v.Vect, m *t.Transform)
+TEXT ·transformPointsSSE2(SB), NOSPLIT, $0-56
+	MOVQ dst_base+0(FP), DI
+	MOVQ dst_len+8(FP), CX
+	MOVQ src_base+24(FP), SI
+	MOVQ m+48(FP), AX
+
+	// X4 = [a b a b], X5 = [c d c d], X6 = [tx ty tx ty]
+	MOVSD   0(AX), X4
+	MOVLHPS X4, X4
+	MOVSD   8(AX), X5
+	MOVLHPS X5, X5
+	MOVSD   16(AX), X6
+	MOVLHPS X6, X6
+
+	SHRQ $1, CX
+	JZ   tpDone
+
+tpLoop:
+	MOVUPS (SI), X0          // [x0 y0 x1 y1]
+	MOVAPS X0, X1
+	SHUFPS $0xA0, X0, X0     // [x0 x0 x1 x1]
+	SHUFPS $0xF5, X1, X1     // [y0 y0 y1 y1]
+	MULPS  X4, X0
+	MULPS  X5, X1
+	ADDPS  X1, X0
+	ADDPS  X6, X0
+	MOVUPS X0, (DI)
+	ADDQ   $16, SI
+	ADDQ   $16, DI
+	DECQ   CX
+	JNZ    tpLoop
+
+tpDone:
+	RET
+
+// func transformPointsAVX4(dst, src []v.Vect, m *t.Transform)
+TEXT ·transformPointsAVX4(SB), NOSPLIT, $0-56
+	MOVQ dst_base+0(FP), DI
+	MOVQ dst_len+8(FP), CX
+	MOVQ src_base+24(FP), SI
+	MOVQ m+48(FP), AX
+
+	VBROADCASTSD 0(AX), Y4
+	VBROADCASTSD 8(AX), Y5
+	VBROADCASTSD 16(AX), Y6
+
+	SHRQ $2, CX
+	JZ   tpAVXDone
+
+tpAVXLoop:
+	VMOVUPS (SI), Y0
+	VSHUFPS $0xA0, Y0, Y0, Y1
+	VSHUFPS $0xF5, Y0, Y0, Y2
+	VMULPS  Y4, Y1, Y1
+	VMULPS  Y5, Y2, Y2
+	VADDPS  Y2, Y1, Y1
+	VADDPS  Y6, Y1, Y1
+	VMOVUPS Y1, (DI)
+	ADDQ    $32, SI
+	ADDQ    $32, DI
+	DECQ    CX
+	JNZ     tpAVXLoop
+
+tpAVXDone:
+	VZEROUPPER
+	RET
+
+// func normalizeSSE2(dst, src []v.Vect)
+TEXT ·normalizeSSE2(SB), NOSPLIT, $0-48
+	MOVQ dst_base+0(FP), DI
+	MOVQ dst_len+8(FP), CX
+	MOVQ src_base+24(FP), SI
+
+	MOVSS  one<>(SB), X6
+	SHUFPS $0x00, X6, X6
+	MOVSS  floatMin<>(SB), X7
+	SHUFPS $0x00, X7, X7
+
+	SHRQ $1, CX
+	JZ   nDone
+
+nLoop:
+	MOVUPS (SI), X0          // [x0 y0 x1 y1]
+	MOVAPS X0, X1
+	MULPS  X1, X1            // [x0² y0² x1² y1²]
+	MOVAPS X1, X2
+	SHUFPS $0xB1, X2, X2     // [y0² x0² y1² x1²]
+	ADDPS  X2, X1
+	SQRTPS X1, X1
+	ADDPS  X7, X1
+	MOVAPS X6, X2
+	DIVPS  X1, X2            // 1/(length + FloatMin)
+	MULPS  X2, X0
+	MOVUPS X0, (DI)
+	ADDQ   $16, SI
+	ADDQ   $16, DI
+	DECQ   CX
+	JNZ    nLoop
+
+nDone:
+	RET
+
+// func normalizeAVX4(dst, src []v.Vect)
+TEXT ·normalizeAVX4(SB), NOSPLIT, $0-48
+	MOVQ dst_base+0(FP), DI
+	MOVQ dst_len+8(FP), CX
+	MOVQ src_base+24(FP), SI
+
+	VBROADCASTSS one<>(SB), Y6
+	VBROADCASTSS floatMin<>(SB), Y7
+
+	SHRQ $2, CX
+	JZ   nAVXDone
+
+nAVXLoop:
+	VMOVUPS (SI), Y0
+	VMULPS  Y0, Y0, Y1
+	VSHUFPS $0xB1, Y1, Y1, Y2
+	VADDPS  Y2, Y1, Y1
+	VSQRTPS Y1, Y1
+	VADDPS  Y7, Y1, Y1
+	VDIVPS  Y1, Y6, Y2
+	VMULPS  Y2, Y0, Y0
+	VMOVUPS Y0, (DI)
+	ADDQ    $32, SI
+	ADDQ    $32, DI
+	DECQ    CX
+	JNZ     nAVXLoop
+
+nAVXDone:
+	VZEROUPPER
+	RET
+
+// func mergeSSE1(boxes []aabb.AABB, acc *aabb.AABB)
+TEXT ·mergeSSE1(SB), NOSPLIT, $0-32
+	MOVQ boxes_base+0(FP), SI
+	MOVQ boxes_len+8(FP), CX
+	MOVQ acc+24(FP), AX
+
+	// X0 accumulates the minimum, X1 the maximum of [l b r t].
+	// MINPS and MAXPS return the source operand unless the destination
+	// compares less (greater), so the box goes in the destination and
+	// NaN coordinates keep the accumulator, as in mergeGeneric.
+	MOVUPS (AX), X0
+	MOVAPS X0, X1
+	TESTQ  CX, CX
+	JZ     mDone
+
+mLoop:
+	MOVUPS (SI), X2
+	MOVAPS X2, X3
+	MINPS  X0, X2
+	MAXPS  X1, X3
+	MOVAPS X2, X0
+	MOVAPS X3, X1
+	ADDQ   $16, SI
+	DECQ   CX
+	JNZ    mLoop
+
+mDone:
+	SHUFPS $0xE4, X1, X0     // [min l, min b, max r, max t]
+	MOVUPS X0, (AX)
+	RET
+
+// func mergeAVX2(boxes []aabb.AABB, acc *aabb.AABB)
+TEXT ·mergeAVX2(SB), NOSPLIT, $0-32
+	MOVQ boxes_base+0(FP), SI
+	MOVQ boxes_len+8(FP), CX
+	MOVQ acc+24(FP), AX
+
+	VBROADCASTF128 (AX), Y0
+	VMOVAPS        Y0, Y1
+	SHRQ           $1, CX
+	JZ             mAVXDone
+
+mAVXLoop:
+	// The box is the first source operand, see mergeSSE1.
+	VMOVUPS (SI), Y2
+	VMINPS  Y0, Y2, Y0
+	VMAXPS  Y1, Y2, Y1
+	ADDQ    $32, SI
+	DECQ    CX
+	JNZ     mAVXLoop
+
+mAVXDone:
+	// Fold the upper box into the lower one.
+	VEXTRACTF128 $1, Y0, X2
+	VMINPS       X2, X0, X0
+	VEXTRACTF128 $1, Y1, X3
+	VMAXPS       X3, X1, X1
+	VSHUFPS      $0xE4, X1, X0, X0
+	VMOVUPS      X0, (AX)
+	VZEROUPPER
+	RET
@@ -0,0 +1,139 @@
+package batch
+
+import "github.com/oniproject/math/f"
+import "github.com/oniproject/math/v"
+import "github.com/oniproject/math/aabb"
+import "github.com/oniproject/math/t"
+
+// Vectors as a structure of arrays, X and Y must have the same length.
+type SoA struct {
+	X, Y []f.Float
+}
+
+// Allocate a SoA buffer for n vectors.
+func NewSoA(n int) SoA {
+	return SoA{make([]f.Float, n), make([]f.Float, n)}
+}
+
+// Returns the number of vectors.
+func (s SoA) Len() int { return len(s.X) }
+
+// Returns the i-th vector.
+func (s SoA) At(i int) v.Vect { return v.V(s.X[i], s.Y[i]) }
+
+// Store the vectors of src into dst.
+func ToSoA(dst SoA, src []v.Vect) {
+	xs, ys := dst.X, dst.Y[:len(dst.X)]
+	src = src[:len(xs)]
+	for i := range xs {
+		xs[i], ys[i] = src[i].X, src[i].Y
+	}
+}
+
+// Store the vectors of src into dst.
+func FromSoA(dst []v.Vect, src SoA) {
+	xs, ys := src.X[:len(dst)], src.Y[:len(dst)]
+	for i := range dst {
+		dst[i] = v.V(xs[i], ys[i])
+	}
+}
+
+// Transform the points src by m into dst. (translation included)
+func TransformPointsSoA(dst, src SoA, m t.Transform) {
+	dx, dy := dst.X, dst.Y[:len(dst.X)]
+	sx, sy := src.X[:len(dx)], src.Y[:len(dx)]
+	for i := range dx {
+		x, y := sx[i], sy[i]
+		dx[i] = f.Float(m.A*x) + f.Float(m.C*y) + m.Tx
+		dy[i] = f.Float(m.B*x) + f.Float(m.D*y) + m.Ty
+	}
+}
+
+// Transform the vectors src by m into dst. (translation ignored)
+func TransformVectsSoA(dst, src SoA, m t.Transform) {
+	dx, dy := dst.X, dst.Y[:len(dst.X)]
+	sx, sy := src.X[:len(dx)], src.Y[:len(dx)]
+	for i := range dx {
+		x, y := sx[i], sy[i]
+		dx[i] = f.Float(m.A*x) + f.Float(m.C*y)
+		dy[i] = f.Float(m.B*x) + f.Float(m.D*y)
+	}
+}
+
+// Add a and b element wise into dst.
+func AddSoA(dst, a, b SoA) {
+	addFloats(dst.X, a.X, b.X)
+	addFloats(dst.Y, a.Y, b.Y)
+}
+
+// Add the offset d to every vector of src into dst.
+func OffsetSoA(dst, src SoA, d v.Vect) {
+	offsetFloats(dst.X, src.X, d.X)
+	offsetFloats(dst.Y, src.Y, d.Y)
+}
+
+// Multiply every vector of src by s into dst.
+func ScaleSoA(dst, src SoA, s f.Float) {
+	scaleFloats(dst.X, src.X, s)
+	scaleFloats(dst.Y, src.Y, s)
+}
+
+// Normalize every vector of src into dst, with the same results as v.Normalize.
+func NormalizeSoA(dst, src SoA) {
+	dx, dy := dst.X, dst.Y[:len(dst.X)]
+	sx, sy := src.X[:len(dx)], src.Y[:len(dx)]
+	for i := range dx {
+		x, y := sx[i], sy[i]
+		s := 1.0 / (f.Sqrt(f.Float(x*x)+f.Float(y*y)) + f.FloatMin)
+		dx[i] = x * s
+		dy[i] = y * s
+	}
+}
+
+// Dot products of a and b element wise into dst.
+func DotSoA(dst []f.Float, a, b SoA) {
+	ax, ay := a.X[:len(dst)], a.Y[:len(dst)]
+	bx, by := b.X[:len(dst)], b.Y[:len(dst)]
+	for i := range dst {
+		dst[i] = f.Float(ax[i]*bx[i]) + f.Float(ay[i]*by[i])
+	}
+}
+
+// Returns the bounding box of the points.
+// An empty buffer gives an inverted box from +Inf to -Inf that aabb.Merge ignores.
+func BBSoA(s SoA) aabb.AABB {
+	l, r := bounds(s.X)
+	b, t := bounds(s.Y[:len(s.X)])
+	return aabb.New(l, b, r, t)
+}
+
+func addFloats(dst, a, b []f.Float) {
+	a, b = a[:len(dst)], b[:len(dst)]
+	for i := range dst {
+		dst[i] = a[i] + b[i]
+	}
+}
+
+func offsetFloats(dst, src []f.Float, d f.Float) {
+	src = src[:len(dst)]
+	for i := range dst {
+		dst[i] = src[i] + d
+	}
+}
+
+func scaleFloats(dst, src []f.Float, s f.Float) {
+	src = src[:len(dst)]
+	for i := range dst {
+		dst[i] = src[i] * s
+	}
+}
+
+// Returns the minimum and maximum of xs.
+func bounds(xs []f.Float) (min, max f.Float) {
+	min, max = f.Inf, -f.Inf
+	for _, x := range xs {
+		min = f.Min(min, x)
+		max = f.Max(max, x)
+	}
+	return
+}
@@ -0,0 +1,70 @@
+package bezier
+
+import "sort"
+
+import "github.com/oniproject/math/f"
+import "github.com/oniproject/math/v"
+
+// Arc-length parameterization table of a curve.
+// Maps distances along the curve to curve parameters.
+type ArcLength struct {
+	curve Curve
+	ts    []f.Float
+	dists []f.Float
+}
+
+// Builds an arc-length table by sampling the curve at n+1 uniformly spaced parameters.
+func NewArcLength(c Curve, n int) *ArcLength {
+	if n < 1 {
+		n = 1
+	}
+	a := &ArcLength{
+		curve: c,
+		ts:    make([]f.Float, n+1),
+		dists: make([]f.Float, n+1),
+	}
+
+	prev := c.Point(0.0)
+	for i := 1; i <= n; i++ {
+		t := f.Float(i) / f.Float(n)
+		p := c.Point(t)
+		a.ts[i] = t
+		a.dists[i] = a.dists[i-1] + v.Dist(prev, p)
+		prev = p
+	}
+	return a
+}
+
+// Returns the total length of the curve.
+func (a *ArcLength) Length() f.Float {
+	return a.dists[len(a.dists)-1]
+}
+
+// Returns the curve parameter at distance s along the curve.
+// s is clamped to [0, Length()].
+func (a *ArcLength) T(s f.Float) f.Float {
+	n := len(a.dists)
+	if s <= 0.0 {
+		return 0.0
+	}
+	if s >= a.dists[n-1] {
+		return 1.0
+	}
+
+	i := sort.Search(n, func(i int) bool { return a.dists[i] >= s })
+	d0, d1 := a.dists[i-1], a.dists[i]
+	if d1 == d0 {
+		return a.ts[i]
+	}
+	return f.Lerp(a.ts[i-1], a.ts[i], (s-d0)/(d1-d0))
+}
+
+// Returns the point at distance s along the curve.
+func (a *ArcLength) Point(s f.Float) v.Vect {
+	return a.curve.Point(a.T(s))
+}
+
+// Returns the unit tangent at distance s along the curve.
+func (a *ArcLength) Tangent(s f.Float) v.Vect {
+	return v.Normalize(a.curve.Derivative(a.T(s)))
+}
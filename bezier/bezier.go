@@ -0,0 +1,260 @@
+// Quadratic and cubic Bezier curves over v.Vect.
+package bezier
+
+import "github.com/oniproject/math/aabb"
+import "github.com/oniproject/math/f"
+import "github.com/oniproject/math/v"
+
+// Maximum subdivision depth used by Flatten.
+const maxDepth = 16
+
+// A parametric curve defined for t in [0, 1].
+type Curve interface {
+	Point(t f.Float) v.Vect
+	Derivative(t f.Float) v.Vect
+}
+
+// Quadratic Bezier curve.
+type Quad struct {
+	P0, P1, P2 v.Vect
+}
+
+// Cubic Bezier curve.
+type Cubic struct {
+	P0, P1, P2, P3 v.Vect
+}
+
+// Convenience constructor for Quad structs.
+func NewQuad(p0, p1, p2 v.Vect) Quad { return Quad{p0, p1, p2} }
+
+// Convenience constructor for Cubic structs.
+func NewCubic(p0, p1, p2, p3 v.Vect) Cubic { return Cubic{p0, p1, p2, p3} }
+
+// Returns the point at t using de Casteljau's algorithm.
+func (q Quad) Point(t f.Float) v.Vect {
+	a := v.Lerp(q.P0, q.P1, t)
+	b := v.Lerp(q.P1, q.P2, t)
+	return v.Lerp(a, b, t)
+}
+
+// Returns the first derivative (tangent) at t.
+func (q Quad) Derivative(t f.Float) v.Vect {
+	return v.Mult(v.Lerp(v.Sub(q.P1, q.P0), v.Sub(q.P2, q.P1), t), 2.0)
+}
+
+// Returns the second derivative. It is constant for a quadratic curve.
+func (q Quad) SecondDerivative(t f.Float) v.Vect {
+	return v.Mult(v.Add(v.Sub(q.P0, v.Mult(q.P1, 2.0)), q.P2), 2.0)
+}
+
+// Split the curve at t into two curves covering [0, t] and [t, 1].
+func (q Quad) Split(t f.Float) (Quad, Quad) {
+	a := v.Lerp(q.P0, q.P1, t)
+	b := v.Lerp(q.P1, q.P2, t)
+	m := v.Lerp(a, b, t)
+	return Quad{q.P0, a, m}, Quad{m, b, q.P2}
+}
+
+// Returns the equivalent cubic curve. (degree elevation)
+func (q Quad) Cubic() Cubic {
+	return Cubic{
+		q.P0,
+		v.Lerp(q.P0, q.P1, 2.0/3.0),
+		v.Lerp(q.P2, q.P1, 2.0/3.0),
+		q.P2,
+	}
+}
+
+// Returns the tight bounding box of the curve.
+func (q Quad) BB() aabb.AABB {
+	bb := aabb.New(q.P0.X, q.P0.Y, q.P0.X, q.P0.Y)
+	bb = aabb.Expand(bb, q.P2)
+	for _, t := range quadExtrema(q.P0.X, q.P1.X, q.P2.X, nil) {
+		bb = aabb.Expand(bb, q.Point(t))
+	}
+	for _, t := range quadExtrema(q.P0.Y, q.P1.Y, q.P2.Y, nil) {
+		bb = aabb.Expand(bb, q.Point(t))
+	}
+	return bb
+}
+
+// Appends the parameters in (0, 1) where the derivative of a quadratic 1D Bezier is zero.
+func quadExtrema(p0, p1, p2 f.Float, ts []f.Float) []f.Float {
+	d := p0 - 2.0*p1 + p2
+	if d == 0.0 {
+		return ts
+	}
+	if t := (p0 - p1) / d; 0.0 < t && t < 1.0 {
+		ts = append(ts, t)
+	}
+	return ts
+}
+
+// Returns the point at t using de Casteljau's algorithm.
+func (c Cubic) Point(t f.Float) v.Vect {
+	a := v.Lerp(c.P0, c.P1, t)
+	b := v.Lerp(c.P1, c.P2, t)
+	d := v.Lerp(c.P2, c.P3, t)
+	return v.Lerp(v.Lerp(a, b, t), v.Lerp(b, d, t), t)
+}
+
+// Returns the first derivative (tangent) at t.
+func (c Cubic) Derivative(t f.Float) v.Vect {
+	q := Quad{v.Sub(c.P1, c.P0), v.Sub(c.P2, c.P1), v.Sub(c.P3, c.P2)}
+	return v.Mult(q.Point(t), 3.0)
+}
+
+// Returns the second derivative at t.
+func (c Cubic) SecondDerivative(t f.Float) v.Vect {
+	a := v.Add(v.Sub(c.P0, v.Mult(c.P1, 2.0)), c.P2)
+	b := v.Add(v.Sub(c.P1, v.Mult(c.P2, 2.0)), c.P3)
+	return v.Mult(v.Lerp(a, b, t), 6.0)
+}
+
+// Split the curve at t into two curves covering [0, t] and [t, 1].
+func (c Cubic) Split(t f.Float) (Cubic, Cubic) {
+	a := v.Lerp(c.P0, c.P1, t)
+	b := v.Lerp(c.P1, c.P2, t)
+	d := v.Lerp(c.P2, c.P3, t)
+	ab := v.Lerp(a, b, t)
+	bd := v.Lerp(b, d, t)
+	m := v.Lerp(ab, bd, t)
+	return Cubic{c.P0, a, ab, m}, Cubic{m, bd, d, c.P3}
+}
+
+// Returns the tight bounding box of the curve.
+func (c Cubic) BB() aabb.AABB {
+	bb := aabb.New(c.P0.X, c.P0.Y, c.P0.X, c.P0.Y)
+	bb = aabb.Expand(bb, c.P3)
+	for _, t := range cubicExtrema(c.P0.X, c.P1.X, c.P2.X, c.P3.X, nil) {
+		bb = aabb.Expand(bb, c.Point(t))
+	}
+	for _, t := range cubicExtrema(c.P0.Y, c.P1.Y, c.P2.Y, c.P3.Y, nil) {
+		bb = aabb.Expand(bb, c.Point(t))
+	}
+	return bb
+}
+
+// Appends the parameters in (0, 1) where the derivative of a cubic 1D Bezier is zero.
+func cubicExtrema(p0, p1, p2, p3 f.Float, ts []f.Float) []f.Float {
+	// Derivative is a*t^2 + b*t + c. (divided by 3)
+	a := -p0 + 3.0*p1 - 3.0*p2 + p3
+	b := 2.0 * (p0 - 2.0*p1 + p2)
+	c := p1 - p0
+
+	if f.Abs(a) < 1e-12 {
+		if b != 0.0 {
+			if t := -c / b; 0.0 < t && t < 1.0 {
+				ts = append(ts, t)
+			}
+		}
+		return ts
+	}
+
+	disc := b*b - 4.0*a*c
+	if disc < 0.0 {
+		return ts
+	}
+	sq := f.Sqrt(disc)
+	for _, t := range [2]f.Float{(-b + sq) / (2.0 * a), (-b - sq) / (2.0 * a)} {
+		if 0.0 < t && t < 1.0 {
+			ts = append(ts, t)
+		}
+	}
+	return ts
+}
+
+// Returns true if the control polygon deviates from the chord by less than tolerance.
+func (q Quad) flat(tolerance f.Float) bool {
+	d := v.Add(v.Sub(q.P0, v.Mult(q.P1, 2.0)), q.P2)
+	return v.LengthSq(d) <= 16.0*tolerance*tolerance
+}
+
+// Returns true if the control polygon deviates from the chord by less than tolerance.
+func (c Cubic) flat(tolerance f.Float) bool {
+	u := v.Sub(v.Sub(v.Mult(c.P1, 3.0), v.Mult(c.P0, 2.0)), c.P3)
+	w := v.Sub(v.Sub(v.Mult(c.P2, 3.0), c.P0), v.Mult(c.P3, 2.0))
+	ux, uy := u.X*u.X, u.Y*u.Y
+	wx, wy := w.X*w.X, w.Y*w.Y
+	return f.Max(ux, wx)+f.Max(uy, wy) <= 16.0*tolerance*tolerance
+}
+
+// Appends a polyline approximating the curve within tolerance to dst.
+// The first point is included only if dst is empty.
+func (q Quad) Flatten(tolerance f.Float, dst []v.Vect) []v.Vect {
+	if len(dst) == 0 {
+		dst = append(dst, q.P0)
+	}
+	return q.flatten(tolerance, dst, 0)
+}
+
+func (q Quad) flatten(tolerance f.Float, dst []v.Vect, depth int) []v.Vect {
+	if depth >= maxDepth || q.flat(tolerance) {
+		return append(dst, q.P2)
+	}
+	a, b := q.Split(0.5)
+	dst = a.flatten(tolerance, dst, depth+1)
+	return b.flatten(tolerance, dst, depth+1)
+}
+
+// Appends a polyline approximating the curve within tolerance to dst.
+// The first point is included only if dst is empty.
+func (c Cubic) Flatten(tolerance f.Float, dst []v.Vect) []v.Vect {
+	if len(dst) == 0 {
+		dst = append(dst, c.P0)
+	}
+	return c.flatten(tolerance, dst, 0)
+}
+
+func (c Cubic) flatten(tolerance f.Float, dst []v.Vect, depth int) []v.Vect {
+	if depth >= maxDepth || c.flat(tolerance) {
+		return append(dst, c.P3)
+	}
+	a, b := c.Split(0.5)
+	dst = a.flatten(tolerance, dst, depth+1)
+	return b.flatten(tolerance, dst, depth+1)
+}
+
+// Returns the parameter and the point on the curve nearest to p.
+func (q Quad) Nearest(p v.Vect) (f.Float, v.Vect) {
+	return nearest(q, q.SecondDerivative, p)
+}
+
+// Returns the parameter and the point on the curve nearest to p.
+func (c Cubic) Nearest(p v.Vect) (f.Float, v.Vect) {
+	return nearest(c, c.SecondDerivative, p)
+}
+
+// Coarse sampling followed by Newton refinement of (B(t) - p)·B'(t) = 0.
+func nearest(c Curve, second func(t f.Float) v.Vect, p v.Vect) (f.Float, v.Vect) {
+	const samples = 16
+
+	best, bestDist := f.Float(0.0), f.Inf
+	for i := 0; i <= samples; i++ {
+		t := f.Float(i) / samples
+		if d := v.DistSq(c.Point(t), p); d < bestDist {
+			best, bestDist = t, d
+		}
+	}
+
+	t := best
+	for i := 0; i < 8; i++ {
+		d := v.Sub(c.Point(t), p)
+		d1 := c.Derivative(t)
+		denom := v.Dot(d1, d1) + v.Dot(d, second(t))
+		if denom == 0.0 {
+			break
+		}
+		next := f.Clamp01(t - v.Dot(d, d1)/denom)
+		if f.Abs(next-t) < 1e-7 {
+			t = next
+			break
+		}
+		t = next
+	}
+
+	if v.DistSq(c.Point(t), p) > bestDist {
+		t = best
+	}
+	return t, c.Point(t)
+}
@@ -0,0 +1,104 @@
+package bezier
+
+import (
+	"github.com/oniproject/math/aabb"
+	"github.com/oniproject/math/f"
+	"github.com/oniproject/math/v"
+	. "github.com/smartystreets/goconvey/convey"
+	"testing"
+)
+
+func TestBezier(test *testing.T) {
+	Convey("Bezier", test, func() {
+		q := NewQuad(v.V(0, 0), v.V(1, 2), v.V(2, 0))
+		c := NewCubic(v.V(0, 0), v.V(0, 2), v.V(2, 2), v.V(2, 0))
+
+		Convey("Point", func() {
+			So(q.Point(0), ShouldResemble, q.P0)
+			So(q.Point(1), ShouldResemble, q.P2)
+			So(q.Point(0.5), ShouldResemble, v.V(1, 1))
+			So(c.Point(0), ShouldResemble, c.P0)
+			So(c.Point(1), ShouldResemble, c.P3)
+			So(c.Point(0.5), ShouldResemble, v.V(1, 1.5))
+		})
+
+		Convey("Derivative", func() {
+			So(q.Derivative(0), ShouldResemble, v.V(2, 4))
+			So(q.Derivative(0.5), ShouldResemble, v.V(2, 0))
+			So(c.Derivative(0), ShouldResemble, v.V(0, 6))
+			So(c.Derivative(0.5), ShouldResemble, v.V(3, 0))
+		})
+
+		Convey("Split", func() {
+			a, b := c.Split(0.25)
+			So(a.Point(1), ShouldResemble, c.Point(0.25))
+			So(b.Point(0), ShouldResemble, c.Point(0.25))
+			So(a.Point(0.5), ShouldResemble, c.Point(0.125))
+
+			qa, qb := q.Split(0.5)
+			So(qa.P2, ShouldResemble, v.V(1, 1))
+			So(qb.P0, ShouldResemble, v.V(1, 1))
+		})
+
+		Convey("Cubic", func() {
+			e := q.Cubic()
+			for _, t := range []f.Float{0, 0.25, 0.5, 1} {
+				So(e.Point(t).X, ShouldAlmostEqual, q.Point(t).X, 1e-6)
+				So(e.Point(t).Y, ShouldAlmostEqual, q.Point(t).Y, 1e-6)
+			}
+		})
+
+		Convey("BB", func() {
+			So(q.BB(), ShouldResemble, aabb.New(0, 0, 2, 1))
+			So(c.BB(), ShouldResemble, aabb.New(0, 0, 2, 1.5))
+
+			s := NewCubic(v.V(0, 0), v.V(3, -2), v.V(-1, 3), v.V(2, 1))
+			bb := s.BB()
+			So(bb.B, ShouldBeLessThan, 0)
+			var sampled aabb.AABB
+			for i := 0; i <= 1000; i++ {
+				p := s.Point(f.Float(i) / 1000)
+				So(bb.ContainsVect(p), ShouldBeTrue)
+				sampled = aabb.Expand(sampled, p)
+			}
+			So(bb.L, ShouldAlmostEqual, sampled.L, 1e-4)
+			So(bb.B, ShouldAlmostEqual, sampled.B, 1e-4)
+			So(bb.R, ShouldAlmostEqual, sampled.R, 1e-4)
+			So(bb.T, ShouldAlmostEqual, sampled.T, 1e-4)
+		})
+
+		Convey("Flatten", func() {
+			pts := c.Flatten(0.01, nil)
+			So(pts[0], ShouldResemble, c.P0)
+			So(pts[len(pts)-1], ShouldResemble, c.P3)
+			So(len(pts), ShouldBeGreaterThan, 4)
+			for i := 1; i < len(pts); i++ {
+				_, p := c.Nearest(v.Lerp(pts[i-1], pts[i], 0.5))
+				So(v.Dist(p, v.Lerp(pts[i-1], pts[i], 0.5)), ShouldBeLessThan, 0.01)
+			}
+
+			line := NewQuad(v.V(0, 0), v.V(1, 0), v.V(2, 0))
+			So(line.Flatten(0.01, nil), ShouldResemble, []v.Vect{v.V(0, 0), v.V(2, 0)})
+		})
+
+		Convey("Nearest", func() {
+			t, p := c.Nearest(v.V(1, 5))
+			So(t, ShouldAlmostEqual, 0.5, 1e-5)
+			So(p.Y, ShouldAlmostEqual, 1.5, 1e-5)
+
+			t, p = q.Nearest(v.V(-1, -1))
+			So(t, ShouldEqual, 0)
+			So(p, ShouldResemble, q.P0)
+		})
+
+		Convey("ArcLength", func() {
+			line := NewCubic(v.V(0, 0), v.V(0.1, 0), v.V(0.2, 0), v.V(10, 0))
+			a := NewArcLength(line, 256)
+			So(a.Length(), ShouldAlmostEqual, 10, 1e-4)
+			So(a.Point(0), ShouldResemble, line.P0)
+			So(a.Point(20), ShouldResemble, line.P3)
+			So(a.Point(5).X, ShouldAlmostEqual, 5, 1e-2)
+			So(a.Tangent(5), ShouldResemble, v.V(1, 0))
+		})
+	})
+}
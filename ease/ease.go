@@ -0,0 +1,244 @@
+// Robert Penner's easing functions, CSS timing functions and steps.
+//
+// An easing function maps the linear progress t in [0, 1] to an eased progress,
+// which can be passed on to f.Lerp or v.Lerp. Most functions return 0 at t = 0
+// and 1 at t = 1, Back and Elastic overshoot in between.
+package ease
+
+import "github.com/oniproject/math/f"
+import "github.com/oniproject/math/v"
+
+// Easing function.
+type Func func(t f.Float) f.Float
+
+// Interpolate between f1 and f2 by the eased t.
+func (e Func) Lerp(f1, f2, t f.Float) f.Float {
+	return f.Lerp(f1, f2, e(t))
+}
+
+// Interpolate between v1 and v2 by the eased t.
+func (e Func) LerpVect(v1, v2 v.Vect, t f.Float) v.Vect {
+	return v.Lerp(v1, v2, e(t))
+}
+
+// Returns the ease-out function mirroring the ease-in function in.
+func Out(in Func) Func {
+	return func(t f.Float) f.Float { return 1.0 - in(1.0-t) }
+}
+
+// Returns the ease-in-out function built from the ease-in function in.
+func InOut(in Func) Func {
+	return func(t f.Float) f.Float {
+		if t < 0.5 {
+			return in(t*2.0) * 0.5
+		}
+		return 1.0 - in((1.0-t)*2.0)*0.5
+	}
+}
+
+// Returns e played backwards.
+func Reverse(e Func) Func {
+	return func(t f.Float) f.Float { return e(1.0 - t) }
+}
+
+// Returns the composition outer(inner(t)).
+func Compose(outer, inner Func) Func {
+	return func(t f.Float) f.Float { return outer(inner(t)) }
+}
+
+// No easing, returns t.
+func Linear(t f.Float) f.Float { return t }
+
+// Quadratic easing in, t in [0, 1].
+func InQuad(t f.Float) f.Float { return t * t }
+
+// Cubic easing in, t in [0, 1].
+func InCubic(t f.Float) f.Float { return t * t * t }
+
+// Quartic easing in, t in [0, 1].
+func InQuart(t f.Float) f.Float { return t * t * t * t }
+
+// Quintic easing in, t in [0, 1].
+func InQuint(t f.Float) f.Float { return t * t * t * t * t }
+
+// Sinusoidal easing in, t in [0, 1].
+func InSine(t f.Float) f.Float { return 1.0 - f.Cos(t*f.Pi*0.5) }
+
+// Circular easing in, t in [0, 1].
+func InCirc(t f.Float) f.Float { return 1.0 - f.Sqrt(1.0-t*t) }
+
+// Exponential easing in, t in [0, 1].
+func InExpo(t f.Float) f.Float {
+	if t <= 0.0 {
+		return 0.0
+	}
+	return f.Pow(2.0, 10.0*(t-1.0))
+}
+
+// Overshoot used by the Back functions.
+const BackOvershoot f.Float = 1.70158
+
+// Easing in that backs up by BackOvershoot first, t in [0, 1].
+func InBack(t f.Float) f.Float {
+	return t * t * ((BackOvershoot+1.0)*t - BackOvershoot)
+}
+
+// Elastic easing in, oscillating with growing amplitude, t in [0, 1].
+func InElastic(t f.Float) f.Float {
+	if t <= 0.0 || t >= 1.0 {
+		return f.Clamp01(t)
+	}
+	const period = 0.3
+	return -f.Pow(2.0, 10.0*(t-1.0)) * f.Sin((t-1.0-period/4.0)*2.0*f.Pi/period)
+}
+
+// Bouncing easing out, t in [0, 1].
+func OutBounce(t f.Float) f.Float {
+	const n, d = 7.5625, 2.75
+	switch {
+	case t < 1.0/d:
+		return n * t * t
+	case t < 2.0/d:
+		t -= 1.5 / d
+		return n*t*t + 0.75
+	case t < 2.5/d:
+		t -= 2.25 / d
+		return n*t*t + 0.9375
+	}
+	t -= 2.625 / d
+	return n*t*t + 0.984375
+}
+
+// Bouncing easing in, t in [0, 1].
+func InBounce(t f.Float) f.Float { return 1.0 - OutBounce(1.0-t) }
+
+// Easing out counterparts of the In functions, see Out.
+var (
+	OutQuad    = Out(InQuad)
+	OutCubic   = Out(InCubic)
+	OutQuart   = Out(InQuart)
+	OutQuint   = Out(InQuint)
+	OutSine    = Out(InSine)
+	OutExpo    = Out(InExpo)
+	OutCirc    = Out(InCirc)
+	OutBack    = Out(InBack)
+	OutElastic = Out(InElastic)
+)
+
+// Easing in and out counterparts of the In functions, see InOut.
+var (
+	InOutQuad    = InOut(InQuad)
+	InOutCubic   = InOut(InCubic)
+	InOutQuart   = InOut(InQuart)
+	InOutQuint   = InOut(InQuint)
+	InOutSine    = InOut(InSine)
+	InOutExpo    = InOut(InExpo)
+	InOutCirc    = InOut(InCirc)
+	InOutBack    = InOut(InBack)
+	InOutElastic = InOut(InElastic)
+	InOutBounce  = InOut(InBounce)
+)
+
+// CSS cubic-bezier(x1, y1, x2, y2) timing function.
+// The curve starts at (0, 0) and ends at (1, 1), x1 and x2 must be in [0, 1].
+func CubicBezier(x1, y1, x2, y2 f.Float) Func {
+	// Polynomial coefficients of x(s) and y(s).
+	cx := 3.0 * x1
+	bx := 3.0*(x2-x1) - cx
+	ax := 1.0 - cx - bx
+	cy := 3.0 * y1
+	by := 3.0*(y2-y1) - cy
+	ay := 1.0 - cy - by
+
+	sampleX := func(s f.Float) f.Float { return ((ax*s+bx)*s + cx) * s }
+	sampleY := func(s f.Float) f.Float { return ((ay*s+by)*s + cy) * s }
+	slopeX := func(s f.Float) f.Float { return (3.0*ax*s+2.0*bx)*s + cx }
+
+	const epsilon = 1e-6
+	return func(t f.Float) f.Float {
+		if t <= 0.0 || t >= 1.0 {
+			return f.Clamp01(t)
+		}
+
+		// Newton's method first, bisection if it fails to converge.
+		s := t
+		for i := 0; i < 8; i++ {
+			x := sampleX(s) - t
+			if f.Abs(x) < epsilon {
+				return sampleY(s)
+			}
+			d := slopeX(s)
+			if f.Abs(d) < epsilon {
+				break
+			}
+			s -= x / d
+		}
+
+		lo, hi := f.Float(0.0), f.Float(1.0)
+		s = t
+		for i := 0; i < 32 && lo < hi; i++ {
+			x := sampleX(s)
+			if f.Abs(x-t) < epsilon {
+				break
+			}
+			if t > x {
+				lo = s
+			} else {
+				hi = s
+			}
+			s = (lo + hi) * 0.5
+		}
+		return sampleY(s)
+	}
+}
+
+// Standard CSS timing functions.
+var (
+	Ease      = CubicBezier(0.25, 0.1, 0.25, 1.0)
+	EaseIn    = CubicBezier(0.42, 0.0, 1.0, 1.0)
+	EaseOut   = CubicBezier(0.0, 0.0, 0.58, 1.0)
+	EaseInOut = CubicBezier(0.42, 0.0, 0.58, 1.0)
+)
+
+// Jump position of the CSS steps() timing function.
+type Jump int
+
+const (
+	// Jump at the end of every step, the CSS default.
+	JumpEnd Jump = iota
+	// Jump at the start of every step.
+	JumpStart
+	// No jump at either end, the first and last steps hold 0 and 1.
+	JumpNone
+	// Jump at both the start and the end.
+	JumpBoth
+)
+
+// CSS steps(n, jump) timing function.
+func Steps(n int, jump Jump) Func {
+	if n < 1 {
+		n = 1
+	}
+	if jump == JumpNone && n < 2 {
+		n = 2
+	}
+	steps := f.Float(n)
+	return func(t f.Float) f.Float {
+		if t < 0.0 || t > 1.0 {
+			return f.Clamp01(t)
+		}
+		step := f.Floor(t * steps)
+		if jump == JumpStart || jump == JumpBoth {
+			step += 1.0
+		}
+
+		jumps := steps
+		switch jump {
+		case JumpNone:
+			jumps -= 1.0
+		case JumpBoth:
+			jumps += 1.0
+		}
+		return f.Clamp01(step / jumps)
+	}
+}
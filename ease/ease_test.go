@@ -0,0 +1,81 @@
+package ease
+
+import (
+	"github.com/oniproject/math/f"
+	"github.com/oniproject/math/v"
+	. "github.com/smartystreets/goconvey/convey"
+	"testing"
+)
+
+func TestEase(test *testing.T) {
+	Convey("Ease", test, func() {
+		all := map[string]Func{
+			"Linear": Linear,
+			"InQuad": InQuad, "OutQuad": OutQuad, "InOutQuad": InOutQuad,
+			"InCubic": InCubic, "OutCubic": OutCubic, "InOutCubic": InOutCubic,
+			"InQuart": InQuart, "OutQuart": OutQuart, "InOutQuart": InOutQuart,
+			"InQuint": InQuint, "OutQuint": OutQuint, "InOutQuint": InOutQuint,
+			"InSine": InSine, "OutSine": OutSine, "InOutSine": InOutSine,
+			"InExpo": InExpo, "OutExpo": OutExpo, "InOutExpo": InOutExpo,
+			"InCirc": InCirc, "OutCirc": OutCirc, "InOutCirc": InOutCirc,
+			"InBack": InBack, "OutBack": OutBack, "InOutBack": InOutBack,
+			"InElastic": InElastic, "OutElastic": OutElastic, "InOutElastic": InOutElastic,
+			"InBounce": InBounce, "OutBounce": OutBounce, "InOutBounce": InOutBounce,
+			"Ease": Ease, "EaseIn": EaseIn, "EaseOut": EaseOut, "EaseInOut": EaseInOut,
+		}
+
+		Convey("End points", func() {
+			for name, e := range all {
+				Convey(name, func() {
+					So(e(0), ShouldAlmostEqual, 0, 1e-3)
+					So(e(1), ShouldAlmostEqual, 1, 1e-3)
+				})
+			}
+		})
+
+		Convey("Symmetry", func() {
+			So(InOutQuad(0.5), ShouldEqual, 0.5)
+			So(InOutCubic(0.25), ShouldAlmostEqual, 1-InOutCubic(0.75), 1e-6)
+			So(OutQuad(0.25), ShouldAlmostEqual, 1-InQuad(0.75), 1e-6)
+			So(Reverse(InQuad)(0.25), ShouldEqual, InQuad(0.75))
+			So(InBack(0.2), ShouldBeLessThan, 0)
+			So(OutBack(0.8), ShouldBeGreaterThan, 1)
+		})
+
+		Convey("CubicBezier", func() {
+			linear := CubicBezier(0, 0, 1, 1)
+			for _, t := range []f.Float{0.1, 0.3, 0.5, 0.9} {
+				So(linear(t), ShouldAlmostEqual, t, 1e-5)
+			}
+			So(EaseInOut(0.5), ShouldAlmostEqual, 0.5, 1e-5)
+			So(Ease(0.5), ShouldAlmostEqual, 0.8024, 1e-3)
+		})
+
+		Convey("Steps", func() {
+			end := Steps(4, JumpEnd)
+			So(end(0), ShouldEqual, 0)
+			So(end(0.3), ShouldEqual, 0.25)
+			So(end(0.99), ShouldEqual, 0.75)
+			So(end(1), ShouldEqual, 1)
+
+			start := Steps(4, JumpStart)
+			So(start(0), ShouldEqual, 0.25)
+			So(start(0.8), ShouldEqual, 1)
+
+			none := Steps(3, JumpNone)
+			So(none(0), ShouldEqual, 0)
+			So(none(0.5), ShouldEqual, 0.5)
+			So(none(1), ShouldEqual, 1)
+
+			both := Steps(3, JumpBoth)
+			So(both(0), ShouldEqual, 0.25)
+			So(both(0.99), ShouldEqual, 0.75)
+		})
+
+		Convey("Lerp", func() {
+			So(Func(InQuad).Lerp(2, 4, 0.5), ShouldEqual, 2.5)
+			So(Func(InQuad).LerpVect(v.V(0, 0), v.V(4, 8), 0.5), ShouldResemble, v.V(1, 2))
+			So(Compose(InQuad, InQuad)(0.5), ShouldEqual, 0.0625)
+		})
+	})
+}
@@ -0,0 +1,72 @@
+package f
+
+const twoPi = 2 * Pi
+
+// Converts degrees to radians.
+func Deg2Rad(deg Float) Float { return deg * (Pi / 180) }
+
+// Converts radians to degrees.
+func Rad2Deg(rad Float) Float { return rad * (180 / Pi) }
+
+// Wraps an angle in radians into [-Pi, Pi).
+func NormalizeAngle(a Float) Float { return Wrap(a, -Pi, Pi) }
+
+// Wraps an angle in radians into [0, 2*Pi).
+func NormalizeAnglePositive(a Float) Float { return Wrap(a, 0, twoPi) }
+
+// Returns the shortest signed rotation from one angle to another, in [-Pi, Pi).
+func DeltaAngle(from, to Float) Float {
+	return NormalizeAngle(to - from)
+}
+
+// Interpolate between two angles by t percent along the shortest path.
+// The result is not normalized.
+func LerpAngle(a, b, t Float) Float {
+	return a + DeltaAngle(a, b)*t
+}
+
+// Rotate current towards target by no more than maxDelta along the shortest path.
+// Returns target once it is reached.
+func MoveTowardsAngle(current, target, maxDelta Float) Float {
+	d := DeltaAngle(current, target)
+	if Abs(d) <= maxDelta {
+		return target
+	}
+	return current + Copysign(maxDelta, d)
+}
+
+// Angle in radians.
+// v.FromAngle and v.AngleOf convert from and to vectors,
+// Float(a) passes it to v.ForAngle or t.Rotate.
+type Angle Float
+
+// Constructs an angle from degrees.
+func Degrees(deg Float) Angle { return Angle(Deg2Rad(deg)) }
+
+// Returns the angle in radians.
+func (a Angle) Radians() Float { return Float(a) }
+
+// Returns the angle in degrees.
+func (a Angle) Degrees() Float { return Rad2Deg(Float(a)) }
+
+// Returns the sine and cosine of the angle, the same as v.ForAngle's y and x.
+func (a Angle) Sincos() (sin, cos Float) { return Sin(Float(a)), Cos(Float(a)) }
+
+// Returns the angle wrapped into [-Pi, Pi).
+func (a Angle) Normalize() Angle { return Angle(NormalizeAngle(Float(a))) }
+
+// Returns the angle wrapped into [0, 2*Pi).
+func (a Angle) NormalizePositive() Angle { return Angle(NormalizeAnglePositive(Float(a))) }
+
+// Returns the shortest signed rotation to another angle.
+func (a Angle) Delta(to Angle) Angle { return Angle(DeltaAngle(Float(a), Float(to))) }
+
+// Interpolate to another angle by t percent along the shortest path.
+func (a Angle) Lerp(to Angle, t Float) Angle {
+	return Angle(LerpAngle(Float(a), Float(to), t))
+}
+
+// Rotate towards target by no more than maxDelta along the shortest path.
+func (a Angle) MoveTowards(target, maxDelta Angle) Angle {
+	return Angle(MoveTowardsAngle(Float(a), Float(target), Float(maxDelta)))
+}
@@ -0,0 +1,67 @@
+package f
+
+import (
+	. "github.com/smartystreets/goconvey/convey"
+	"testing"
+)
+
+func TestAngle(test *testing.T) {
+	Convey("Angles", test, func() {
+		Convey("Conversion", func() {
+			So(Deg2Rad(180), ShouldEqual, Pi)
+			So(Rad2Deg(Pi/2), ShouldEqual, 90)
+			So(Degrees(90).Radians(), ShouldEqual, Pi/2)
+			So(Angle(Pi).Degrees(), ShouldEqual, 180)
+		})
+		Convey("Normalize", func() {
+			So(NormalizeAngle(0), ShouldEqual, 0)
+			So(NormalizeAngle(3*Pi/2), ShouldAlmostEqual, -Pi/2, 1e-6)
+			So(NormalizeAngle(-3*Pi/2), ShouldAlmostEqual, Pi/2, 1e-6)
+			So(NormalizeAngle(Pi), ShouldEqual, -Pi)
+			So(NormalizeAngle(-Pi), ShouldEqual, -Pi)
+			So(NormalizeAngle(7*Pi), ShouldAlmostEqual, -Pi, 1e-5)
+			So(NormalizeAnglePositive(-Pi/2), ShouldAlmostEqual, 3*Pi/2, 1e-6)
+			So(NormalizeAnglePositive(2*Pi), ShouldEqual, 0)
+
+			for a := Float(-20); a < 20; a += 0.01 {
+				n := NormalizeAngle(a)
+				So(n >= -Pi && n < Pi, ShouldBeTrue)
+				p := NormalizeAnglePositive(a)
+				So(p >= 0 && p < 2*Pi, ShouldBeTrue)
+			}
+		})
+		Convey("Delta", func() {
+			So(DeltaAngle(0, Pi/2), ShouldAlmostEqual, Pi/2, 1e-6)
+			So(DeltaAngle(Pi/2, 0), ShouldAlmostEqual, -Pi/2, 1e-6)
+			// Across the wraparound.
+			So(DeltaAngle(Deg2Rad(170), Deg2Rad(-170)), ShouldAlmostEqual, Deg2Rad(20), 1e-5)
+			So(DeltaAngle(Deg2Rad(-170), Deg2Rad(170)), ShouldAlmostEqual, Deg2Rad(-20), 1e-5)
+			So(DeltaAngle(0, 4*Pi), ShouldAlmostEqual, 0, 1e-5)
+		})
+		Convey("Lerp", func() {
+			a := LerpAngle(Deg2Rad(170), Deg2Rad(-170), 0.5)
+			So(NormalizeAngle(a), ShouldAlmostEqual, -Pi, 1e-5)
+			So(LerpAngle(0, Pi/2, 0.5), ShouldAlmostEqual, Pi/4, 1e-6)
+			So(LerpAngle(1, 2, 0), ShouldEqual, 1)
+		})
+		Convey("MoveTowards", func() {
+			So(MoveTowardsAngle(0, 1, 0.25), ShouldEqual, 0.25)
+			So(MoveTowardsAngle(0, -1, 0.25), ShouldEqual, -0.25)
+			So(MoveTowardsAngle(0, 1, 2), ShouldEqual, 1)
+			// The short way around goes down from 170° to -170°.
+			a := MoveTowardsAngle(Deg2Rad(170), Deg2Rad(-170), Deg2Rad(5))
+			So(Rad2Deg(a), ShouldAlmostEqual, 175, 1e-4)
+		})
+		Convey("Methods", func() {
+			a := Degrees(170)
+			b := Degrees(-170)
+			So(a.Delta(b).Degrees(), ShouldAlmostEqual, 20, 1e-4)
+			So(a.Lerp(b, 0.5).Normalize(), ShouldAlmostEqual, -Pi, 1e-5)
+			So(a.MoveTowards(b, Degrees(5)).Degrees(), ShouldAlmostEqual, 175, 1e-4)
+			So(Degrees(-90).NormalizePositive().Degrees(), ShouldAlmostEqual, 270, 1e-4)
+			s, c := Degrees(90).Sincos()
+			So(s, ShouldAlmostEqual, 1, 1e-6)
+			So(c, ShouldAlmostEqual, 0, 1e-6)
+		})
+	})
+}
@@ -0,0 +1,97 @@
+// Fast approximations of the f math functions trading accuracy for speed.
+//
+// The signatures match their f counterparts so they can be swapped in
+// where a few digits are enough, like particle effects.
+// The error bounds are measured in approx_test.go.
+package approx
+
+import "math"
+import "github.com/oniproject/math/f"
+
+const (
+	halfPi   f.Float = math.Pi / 2
+	twoPi    f.Float = 2 * math.Pi
+	invTwoPi f.Float = 1 / (2 * math.Pi)
+)
+
+// Largest integer not greater than x, x must fit in an int.
+func floor(x f.Float) f.Float {
+	i := f.Float(int(x))
+	if i > x {
+		i--
+	}
+	return i
+}
+
+// Reciprocal square root using the bit trick with one Newton step.
+// Relative error below 0.18%.
+func InvSqrt(x f.Float) f.Float {
+	var y f.Float
+	if f.Bits == 32 {
+		y = f.Float(math.Float32frombits(0x5f375a86 - math.Float32bits(float32(x))>>1))
+	} else {
+		y = f.Float(math.Float64frombits(0x5fe6eb50c7b537a9 - math.Float64bits(float64(x))>>1))
+	}
+	return y * (1.5 - 0.5*x*y*y)
+}
+
+// Square root as x times InvSqrt(x). Relative error below 0.18%.
+func Sqrt(x f.Float) f.Float {
+	if x <= 0 {
+		return 0
+	}
+	return x * InvSqrt(x)
+}
+
+// Wrap x to [-Pi, Pi), x must be small enough to fit in an int turns.
+func wrapPi(x f.Float) f.Float {
+	return x - twoPi*floor(x*invTwoPi+0.5)
+}
+
+// Sine using a parabola with one refinement step.
+// Absolute error below 0.0011.
+func ParabolicSin(x f.Float) f.Float {
+	const (
+		b = 4 / math.Pi
+		c = -4 / (math.Pi * math.Pi)
+		p = 0.225
+	)
+	x = wrapPi(x)
+	y := b*x + c*x*f.Abs(x)
+	return p*(y*f.Abs(y)-y) + y
+}
+
+// Cosine using a parabola with one refinement step.
+// Absolute error below 0.0011.
+func ParabolicCos(x f.Float) f.Float {
+	return ParabolicSin(x + halfPi)
+}
+
+// Arc tangent of a value in [-1, 1] with a degree 9 minimax polynomial.
+func atan(z f.Float) f.Float {
+	zz := z * z
+	return z * (0.9998660 + zz*(-0.3302995+zz*(0.1801410+zz*(-0.0851330+zz*0.0208351))))
+}
+
+// Arc tangent of y/x. Absolute error below 2e-5.
+// Has the argument order of f.Atan2.
+func Atan2(y, x f.Float) f.Float {
+	ax, ay := f.Abs(x), f.Abs(y)
+	if ax == 0 && ay == 0 {
+		return 0
+	}
+
+	var a f.Float
+	if ay > ax {
+		a = halfPi - atan(ax/ay)
+	} else {
+		a = atan(ay / ax)
+	}
+	if x < 0 {
+		a = math.Pi - a
+	}
+	if y < 0 {
+		a = -a
+	}
+	return a
+}
@@ -0,0 +1,133 @@
+package approx
+
+import (
+	"github.com/oniproject/math/f"
+	. "github.com/smartystreets/goconvey/convey"
+	"math"
+	"testing"
+)
+
+// Maximum absolute error of fn against ref over n samples in [lo, hi].
+func maxErr(fn func(f.Float) f.Float, ref func(float64) float64, lo, hi float64, n int) float64 {
+	var max float64
+	for i := 0; i <= n; i++ {
+		x := lo + (hi-lo)*float64(i)/float64(n)
+		if d := math.Abs(float64(fn(f.Float(x))) - ref(x)); d > max {
+			max = d
+		}
+	}
+	return max
+}
+
+// Maximum relative error of fn against ref over n samples in [lo, hi].
+func maxRelErr(fn func(f.Float) f.Float, ref func(float64) float64, lo, hi float64, n int) float64 {
+	var max float64
+	for i := 0; i <= n; i++ {
+		x := lo + (hi-lo)*float64(i)/float64(n)
+		want := ref(float64(f.Float(x)))
+		if d := math.Abs(float64(fn(f.Float(x)))-want) / want; d > max {
+			max = d
+		}
+	}
+	return max
+}
+
+func TestApprox(test *testing.T) {
+	const n = 100000
+	Convey("Approximate math", test, func() {
+		Convey("InvSqrt and Sqrt", func() {
+			rsqrt := func(x float64) float64 { return 1 / math.Sqrt(x) }
+			e := maxRelErr(InvSqrt, rsqrt, 1e-4, 1e4, n)
+			test.Logf("InvSqrt max relative error %g", e)
+			So(e, ShouldBeLessThan, 0.0018)
+
+			e = maxRelErr(Sqrt, math.Sqrt, 1e-4, 1e4, n)
+			test.Logf("Sqrt max relative error %g", e)
+			So(e, ShouldBeLessThan, 0.0018)
+			So(Sqrt(0), ShouldEqual, 0)
+			So(Sqrt(-1), ShouldEqual, 0)
+		})
+		Convey("ParabolicSin and ParabolicCos", func() {
+			e := maxErr(ParabolicSin, math.Sin, -20, 20, n)
+			test.Logf("ParabolicSin max error %g", e)
+			So(e, ShouldBeLessThan, 0.0011)
+
+			e = maxErr(ParabolicCos, math.Cos, -20, 20, n)
+			test.Logf("ParabolicCos max error %g", e)
+			So(e, ShouldBeLessThan, 0.0011)
+		})
+		Convey("Table", func() {
+			So(NewTable(1000).Len(), ShouldEqual, 1024)
+			So(NewTable(1).Len(), ShouldEqual, 4)
+
+			e := maxErr(Sin, math.Sin, -20, 20, n)
+			test.Logf("Sin max error %g with %d entries", e, Default.Len())
+			So(e, ShouldBeLessThan, 1e-5)
+
+			e = maxErr(Cos, math.Cos, -20, 20, n)
+			test.Logf("Cos max error %g with %d entries", e, Default.Len())
+			So(e, ShouldBeLessThan, 1e-5)
+
+			small := NewTable(64)
+			e = maxErr(small.Sin, math.Sin, -20, 20, n)
+			test.Logf("Sin max error %g with %d entries", e, small.Len())
+			So(e, ShouldBeLessThan, 5.0/(64*64))
+			So(e, ShouldBeGreaterThan, 1e-5)
+		})
+		Convey("Atan2", func() {
+			var max float64
+			for i := 0; i < 4096; i++ {
+				a := 2 * math.Pi * float64(i) / 4096
+				for _, r := range []float64{1e-3, 1, 1e4} {
+					y, x := f.Float(r*math.Sin(a)), f.Float(r*math.Cos(a))
+					want := math.Atan2(float64(y), float64(x))
+					d := math.Abs(float64(Atan2(y, x)) - want)
+					if d > math.Pi {
+						// -Pi and Pi are the same angle.
+						d = 2*math.Pi - d
+					}
+					if d > max {
+						max = d
+					}
+				}
+			}
+			test.Logf("Atan2 max error %g", max)
+			So(max, ShouldBeLessThan, 2e-5)
+			So(Atan2(0, 0), ShouldEqual, 0)
+			So(Atan2(1, 0), ShouldAlmostEqual, math.Pi/2, 1e-5)
+			So(Atan2(0, -1), ShouldAlmostEqual, math.Pi, 1e-5)
+		})
+	})
+}
+
+var sink f.Float
+
+func BenchmarkTableSin(b *testing.B) {
+	for i := 0; i < b.N; i++ {
+		sink += Sin(f.Float(i&1023) * 0.01)
+	}
+}
+
+func BenchmarkParabolicSin(b *testing.B) {
+	for i := 0; i < b.N; i++ {
+		sink += ParabolicSin(f.Float(i&1023) * 0.01)
+	}
+}
+
+func BenchmarkSin(b *testing.B) {
+	for i := 0; i < b.N; i++ {
+		sink += f.Sin(f.Float(i&1023) * 0.01)
+	}
+}
+
+func BenchmarkAtan2(b *testing.B) {
+	for i := 0; i < b.N; i++ {
+		sink += Atan2(f.Float(i&1023)*0.01-5, 1.5)
+	}
+}
+
+func BenchmarkInvSqrt(b *testing.B) {
+	for i := 0; i < b.N; i++ {
+		sink += InvSqrt(f.Float(i&1023) + 1)
+	}
+}
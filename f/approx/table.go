@@ -0,0 +1,57 @@
+package approx
+
+import "math"
+import "github.com/oniproject/math/f"
+
+// Lookup table of one period of sine with linear interpolation.
+type Table struct {
+	sin   []f.Float
+	mask  int
+	scale f.Float
+}
+
+// Table with 1024 entries used by Sin and Cos.
+// Absolute error below 1e-5.
+var Default = NewTable(1024)
+
+// Build a table with n entries, rounded up to a power of two.
+// The absolute error is roughly 5/n².
+func NewTable(n int) *Table {
+	size := 4
+	for size < n {
+		size <<= 1
+	}
+	// One extra entry to interpolate past the end.
+	sin := make([]f.Float, size+1)
+	for i := range sin {
+		sin[i] = f.Float(math.Sin(2 * math.Pi * float64(i) / float64(size)))
+	}
+	return &Table{sin, size - 1, f.Float(size) * invTwoPi}
+}
+
+// Returns the number of entries in the table.
+func (t *Table) Len() int { return t.mask + 1 }
+
+// Interpolate the table at the position x in entries.
+func (t *Table) lookup(x f.Float) f.Float {
+	fl := floor(x)
+	i := int(fl) & t.mask
+	a, b := t.sin[i], t.sin[i+1]
+	return a + (b-a)*(x-fl)
+}
+
+// Sine of x, x must be small enough to fit in an int entries.
+func (t *Table) Sin(x f.Float) f.Float {
+	return t.lookup(x * t.scale)
+}
+
+// Cosine of x, x must be small enough to fit in an int entries.
+func (t *Table) Cos(x f.Float) f.Float {
+	return t.lookup(x*t.scale + f.Float(t.Len()/4))
+}
+
+// Sine of x using the Default table.
+func Sin(x f.Float) f.Float { return Default.Sin(x) }
+
+// Cosine of x using the Default table.
+func Cos(x f.Float) f.Float { return Default.Cos(x) }
@@ -0,0 +1,19 @@
+package f
+
+import (
+	. "github.com/smartystreets/goconvey/convey"
+	"testing"
+	"unsafe"
+)
+
+func TestBits(test *testing.T) {
+	Convey("Precision", test, func() {
+		So(unsafe.Sizeof(Float(0))*8, ShouldEqual, Bits)
+		So(FloatMin, ShouldBeGreaterThan, 0)
+		So(1/FloatMin, ShouldBeLessThan, Inf)
+		So(FloatMax, ShouldBeLessThan, Inf)
+		So(Inf, ShouldBeGreaterThan, FloatMax)
+		So(1+Epsilon, ShouldBeGreaterThan, 1)
+		So(1+Epsilon/2, ShouldEqual, 1)
+	})
+}
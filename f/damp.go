@@ -0,0 +1,33 @@
+package f
+
+// Gradually move current towards target like a critically damped spring.
+// velocity holds the current speed and is updated by every call.
+// smoothTime is roughly the time to reach the target, the speed is limited to maxSpeed.
+// (Game Programming Gems 4, chapter 1.10)
+func SmoothDamp(current, target Float, velocity *Float, smoothTime, maxSpeed, dt Float) Float {
+	smoothTime = Max(0.0001, smoothTime)
+	omega := 2.0 / smoothTime
+	x := omega * dt
+	exp := 1.0 / (1.0 + x + 0.48*x*x + 0.235*x*x*x)
+
+	maxChange := maxSpeed * smoothTime
+	change := Clamp(current-target, -maxChange, maxChange)
+	to := current - change
+
+	temp := (*velocity + omega*change) * dt
+	*velocity = (*velocity - omega*temp) * exp
+	output := to + (change+temp)*exp
+
+	// Prevent overshooting.
+	if (target-current > 0.0) == (output > target) {
+		output = target
+		*velocity = 0.0
+	}
+	return output
+}
+
+// Frame rate independent exponential decay of a towards b.
+// lambda is the decay rate, a larger lambda approaches b faster.
+func Damp(a, b, lambda, dt Float) Float {
+	return Lerp(a, b, 1.0-Exp(-lambda*dt))
+}
@@ -0,0 +1,31 @@
+package f
+
+import (
+	. "github.com/smartystreets/goconvey/convey"
+	"testing"
+)
+
+func TestDamp(test *testing.T) {
+	Convey("Damping", test, func() {
+		Convey("SmoothDamp", func() {
+			var x, vel Float = 0, 0
+			for i := 0; i < 100; i++ {
+				last := x
+				x = SmoothDamp(x, 10, &vel, 0.3, Inf, 1.0/60.0)
+				So(x, ShouldBeGreaterThanOrEqualTo, last)
+				So(x, ShouldBeLessThanOrEqualTo, 10)
+			}
+			So(x, ShouldAlmostEqual, 10, 1e-2)
+
+			x, vel = 0, 0
+			x = SmoothDamp(x, 10, &vel, 0.3, 2, 0.1)
+			So(vel, ShouldBeLessThanOrEqualTo, 2)
+		})
+		Convey("Damp", func() {
+			So(Damp(0, 10, 5, 0), ShouldEqual, 0)
+			a := Damp(Damp(0, 10, 5, 0.1), 10, 5, 0.1)
+			b := Damp(0, 10, 5, 0.2)
+			So(a, ShouldAlmostEqual, b, 1e-5)
+		})
+	})
+}
@@ -0,0 +1,17 @@
+package f
+
+// Returns true if a and b differ by at most absEps, or by at most
+// relEps times the larger magnitude of the two.
+// absEps handles values near zero where a relative error is meaningless.
+func ApproxEqual(a, b, absEps, relEps Float) bool {
+	if a == b {
+		return true
+	}
+	d := Abs(a - b)
+	return d <= absEps || d <= relEps*Max(Abs(a), Abs(b))
+}
+
+// Returns true if a and b are at most n representable Floats apart.
+func WithinULPs(a, b Float, n uint64) bool {
+	return ULPDistance(a, b) <= n
+}
@@ -0,0 +1,37 @@
+package f
+
+import (
+	. "github.com/smartystreets/goconvey/convey"
+	"math"
+	"testing"
+)
+
+func TestEqual(test *testing.T) {
+	Convey("Approximate equality", test, func() {
+		Convey("ApproxEqual", func() {
+			So(ApproxEqual(1, 1, 0, 0), ShouldBeTrue)
+			So(ApproxEqual(Inf, Inf, 0, 0), ShouldBeTrue)
+			So(ApproxEqual(1, 1.05, 0.1, 0), ShouldBeTrue)
+			So(ApproxEqual(1, 1.2, 0.1, 0), ShouldBeFalse)
+			So(ApproxEqual(1000, 1001, 0, 1e-3), ShouldBeTrue)
+			So(ApproxEqual(1000, 1002, 0, 1e-3), ShouldBeFalse)
+			So(ApproxEqual(1e-9, -1e-9, 1e-6, 1e-6), ShouldBeTrue)
+			So(ApproxEqual(Float(math.NaN()), Float(math.NaN()), 1, 1), ShouldBeFalse)
+		})
+		Convey("ULPDistance", func() {
+			So(ULPDistance(1, 1), ShouldEqual, 0)
+			So(ULPDistance(1, 1+Epsilon), ShouldEqual, 1)
+			So(ULPDistance(1+Epsilon, 1), ShouldEqual, 1)
+			So(ULPDistance(0, Float(math.Copysign(0, -1))), ShouldEqual, 0)
+			// The smallest subnormals on both sides of zero.
+			tiny := NextAfter(0, 1)
+			So(ULPDistance(-tiny, tiny), ShouldEqual, 2)
+			So(ULPDistance(FloatMax, Inf), ShouldEqual, 1)
+			So(ULPDistance(Float(math.NaN()), 1), ShouldEqual, uint64(math.MaxUint64))
+			So(ULPDistance(-Inf, Inf), ShouldBeGreaterThan, 0)
+
+			So(WithinULPs(1, NextAfter(1, 0), 1), ShouldBeTrue)
+			So(WithinULPs(1, 1+2*Epsilon, 1), ShouldBeFalse)
+		})
+	})
+}
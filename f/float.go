@@ -1,16 +1,15 @@
+// Scalar type and math functions shared by the other packages.
+//
+// Float is float32 by default, build with -tags f64 to switch the whole
+// module to float64.
 package f
 
 import "math"
 
-const (
-	Pi       Float = math.Pi
-	FloatMin Float = math.SmallestNonzeroFloat64
-)
+const Pi Float = math.Pi
 
 var Inf = Float(math.Inf(+1))
 
-type Float float32
-
 func Sqrt(x Float) Float     { return Float(math.Sqrt(float64(x))) }
 func Sin(x Float) Float      { return Float(math.Sin(float64(x))) }
 func Cos(x Float) Float      { return Float(math.Cos(float64(x))) }
@@ -0,0 +1,45 @@
+//go:build !f64
+// +build !f64
+
+package f
+
+import "math"
+
+type Float float32
+
+// Size of Float in bits.
+const Bits = 32
+
+const (
+	// Smallest positive normal Float.
+	FloatMin Float = 0x1p-126
+	// Largest finite Float.
+	FloatMax Float = math.MaxFloat32
+	// Difference between 1 and the next representable Float.
+	Epsilon Float = 0x1p-23
+)
+
+// Returns the next representable Float after x towards y.
+func NextAfter(x, y Float) Float {
+	return Float(math.Nextafter32(float32(x), float32(y)))
+}
+
+// Returns the number of representable Floats between a and b.
+// Zero for +0 and -0, the maximum uint64 if either is NaN.
+func ULPDistance(a, b Float) uint64 {
+	if IsNaN(a) || IsNaN(b) {
+		return math.MaxUint64
+	}
+	key := func(x Float) int64 {
+		bits := math.Float32bits(float32(x))
+		if bits>>31 != 0 {
+			return -int64(bits &^ (1 << 31))
+		}
+		return int64(bits)
+	}
+	d := key(a) - key(b)
+	if d < 0 {
+		d = -d
+	}
+	return uint64(d)
+}
@@ -0,0 +1,46 @@
+//go:build f64
+// +build f64
+
+package f
+
+import "math"
+
+type Float float64
+
+// Size of Float in bits.
+const Bits = 64
+
+const (
+	// Smallest positive normal Float.
+	FloatMin Float = 0x1p-1022
+	// Largest finite Float.
+	FloatMax Float = math.MaxFloat64
+	// Difference between 1 and the next representable Float.
+	Epsilon Float = 0x1p-52
+)
+
+// Returns the next representable Float after x towards y.
+func NextAfter(x, y Float) Float {
+	return Float(math.Nextafter(float64(x), float64(y)))
+}
+
+// Returns the number of representable Floats between a and b.
+// Zero for +0 and -0, the maximum uint64 if either is NaN.
+func ULPDistance(a, b Float) uint64 {
+	if IsNaN(a) || IsNaN(b) {
+		return math.MaxUint64
+	}
+	key := func(x Float) int64 {
+		bits := math.Float64bits(float64(x))
+		if bits>>63 != 0 {
+			return -int64(bits &^ (1 << 63))
+		}
+		return int64(bits)
+	}
+	ka, kb := key(a), key(b)
+	if ka < kb {
+		ka, kb = kb, ka
+	}
+	// Unsigned subtraction does not overflow across the sign.
+	return uint64(ka) - uint64(kb)
+}
@@ -23,6 +23,43 @@ func Test(test *testing.T) {
 			So(IsNaN(Float(math.NaN())), ShouldBeTrue)
 			So(IsNaN(0), ShouldBeFalse)
 			So(IsNaN(1), ShouldBeFalse)
+
+			So(Tan(0.5), ShouldEqual, math.Tan(0.5))
+			So(Asin(0.5), ShouldEqual, math.Asin(0.5))
+			So(Atan(0.5), ShouldEqual, math.Atan(0.5))
+			So(Sinh(0.5), ShouldEqual, math.Sinh(0.5))
+			So(Cosh(0.5), ShouldEqual, math.Cosh(0.5))
+			So(Tanh(0.5), ShouldEqual, math.Tanh(0.5))
+			So(Log(9), ShouldEqual, math.Log(9))
+			So(Log2(8), ShouldEqual, 3)
+			So(Log10(1000), ShouldEqual, 3)
+			So(Hypot(3, 4), ShouldEqual, 5)
+			So(Round(2.5), ShouldEqual, 3)
+			So(Round(-2.5), ShouldEqual, -3)
+			So(Trunc(-2.7), ShouldEqual, -2)
+			So(Copysign(3, -1), ShouldEqual, -3)
+			So(Remainder(5, 3), ShouldEqual, -1)
+			So(Cbrt(27), ShouldEqual, 3)
+			So(FMA(2, 3, 4), ShouldEqual, 10)
+
+			So(IsInf(Inf, 1), ShouldBeTrue)
+			So(IsInf(-Inf, 1), ShouldBeFalse)
+			So(IsInf(-Inf, 0), ShouldBeTrue)
+			So(IsInf(FloatMax, 0), ShouldBeFalse)
+
+			So(NextAfter(1, 2), ShouldEqual, 1+Epsilon)
+			So(NextAfter(1, 0), ShouldEqual, 1-Epsilon/2)
+
+			frac, exp := Frexp(12)
+			So(frac, ShouldEqual, 0.75)
+			So(exp, ShouldEqual, 4)
+			So(Ldexp(frac, exp), ShouldEqual, 12)
+		})
+		Convey("Sign", func() {
+			So(Sign(-5), ShouldEqual, -1)
+			So(Sign(0), ShouldEqual, 0)
+			So(Sign(5), ShouldEqual, 1)
+			So(IsNaN(Sign(Float(math.NaN()))), ShouldBeTrue)
 		})
 
 		Convey("Max", func() {
@@ -68,5 +105,32 @@ func Test(test *testing.T) {
 			So(LerpConst(min, max, -4), ShouldEqual, -4.0)
 		})
 
+		Convey("SmoothStep", func() {
+			So(SmoothStep(1, 3, 0), ShouldEqual, 0)
+			So(SmoothStep(1, 3, 2), ShouldEqual, 0.5)
+			So(SmoothStep(1, 3, 4), ShouldEqual, 1)
+			So(SmoothStep(0, 1, 0.25), ShouldEqual, 0.15625)
+			So(SmootherStep(0, 1, 0.5), ShouldEqual, 0.5)
+			So(SmootherStep(0, 1, 0.25), ShouldAlmostEqual, 0.103515625, 1e-6)
+			So(SmootherStep(0, 1, 2), ShouldEqual, 1)
+		})
+		Convey("InverseLerp and Remap", func() {
+			So(InverseLerp(2, 4, 3), ShouldEqual, 0.5)
+			So(InverseLerp(2, 4, 6), ShouldEqual, 2)
+			So(Lerp(2, 4, InverseLerp(2, 4, 3.5)), ShouldEqual, 3.5)
+			So(Remap(5, 0, 10, 100, 200), ShouldEqual, 150)
+			So(Remap(-5, 0, 10, 100, 200), ShouldEqual, 50)
+		})
+		Convey("Wrap and PingPong", func() {
+			So(Wrap(5, 0, 4), ShouldEqual, 1)
+			So(Wrap(-1, 0, 4), ShouldEqual, 3)
+			So(Wrap(4, 0, 4), ShouldEqual, 0)
+			So(Wrap(7, -2, 2), ShouldEqual, -1)
+			So(PingPong(0.5, 2), ShouldEqual, 0.5)
+			So(PingPong(3, 2), ShouldEqual, 1)
+			So(PingPong(4, 2), ShouldEqual, 0)
+			So(PingPong(-1, 2), ShouldEqual, 1)
+		})
+
 	})
 }
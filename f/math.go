@@ -0,0 +1,48 @@
+package f
+
+import "math"
+
+func Tan(x Float) Float            { return Float(math.Tan(float64(x))) }
+func Asin(x Float) Float           { return Float(math.Asin(float64(x))) }
+func Atan(x Float) Float           { return Float(math.Atan(float64(x))) }
+func Sinh(x Float) Float           { return Float(math.Sinh(float64(x))) }
+func Cosh(x Float) Float           { return Float(math.Cosh(float64(x))) }
+func Tanh(x Float) Float           { return Float(math.Tanh(float64(x))) }
+func Log(x Float) Float            { return Float(math.Log(float64(x))) }
+func Log2(x Float) Float           { return Float(math.Log2(float64(x))) }
+func Log10(x Float) Float          { return Float(math.Log10(float64(x))) }
+func Hypot(p, q Float) Float       { return Float(math.Hypot(float64(p), float64(q))) }
+func Round(x Float) Float          { return Float(math.Round(float64(x))) }
+func Trunc(x Float) Float          { return Float(math.Trunc(float64(x))) }
+func Copysign(x, y Float) Float    { return Float(math.Copysign(float64(x), float64(y))) }
+func Remainder(x, y Float) Float   { return Float(math.Remainder(float64(x), float64(y))) }
+func Cbrt(x Float) Float           { return Float(math.Cbrt(float64(x))) }
+func IsInf(x Float, sign int) bool { return math.IsInf(float64(x), sign) }
+
+// Computes x*y+z rounded once in float64.
+// With a float32 Float the result is rounded a second time.
+func FMA(x, y, z Float) Float {
+	return Float(math.FMA(float64(x), float64(y), float64(z)))
+}
+
+// Breaks x into a fraction in [0.5, 1) and a power of two.
+func Frexp(x Float) (frac Float, exp int) {
+	fr, e := math.Frexp(float64(x))
+	return Float(fr), e
+}
+
+// Returns frac × 2**exp.
+func Ldexp(frac Float, exp int) Float {
+	return Float(math.Ldexp(float64(frac), exp))
+}
+
+// Returns -1, 0 or +1 depending on the sign of x, NaN for NaN.
+func Sign(x Float) Float {
+	switch {
+	case x > 0:
+		return 1
+	case x < 0:
+		return -1
+	}
+	return x
+}
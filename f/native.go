@@ -0,0 +1,285 @@
+package f
+
+import "math"
+
+// Native float32 implementations of the transcendental functions.
+// They avoid the round trip through float64 and use the single precision
+// polynomial approximations of the Cephes library. The maximum errors
+// below are measured against the correctly rounded result, see native_test.go.
+
+const (
+	pi32        float32 = math.Pi
+	halfPi32    float32 = math.Pi / 2
+	quarterPi32 float32 = math.Pi / 4
+
+	// Largest argument the reduction of Sinf and Cosf handles exactly.
+	sinfMaxArg float32 = 8192
+)
+
+// Reduce x >= 0 to z in [-Pi/4, Pi/4] and the even octant j in {0, 2}.
+// Returns true in sign if x was reduced by an extra half turn.
+func reducef(x float32) (z float32, j int, sign bool) {
+	// Pi/4 split into parts of at most 10 significant bits so that every
+	// y*dp product below is exact for y < 2**14, the last part holds the rest.
+	const (
+		dp1 = 0x.c9p+0
+		dp2 = 0x.fd8p-12
+		dp3 = 0x.a88p-22
+		dp4 = 0x.858p-34
+		dp5 = 0x.8c234cp-44
+	)
+	j = int(x * (4 / pi32))
+	y := float32(j)
+	if j&1 != 0 {
+		j++
+		y++
+	}
+	j &= 7
+	if j > 3 {
+		sign = true
+		j -= 4
+	}
+	z = ((((x - y*dp1) - y*dp2) - y*dp3) - y*dp4) - y*dp5
+	return
+}
+
+func sinPolyf(z float32) float32 {
+	zz := z * z
+	return ((-1.9515295891e-4*zz+8.3321608736e-3)*zz-1.6666654611e-1)*zz*z + z
+}
+
+func cosPolyf(z float32) float32 {
+	zz := z * z
+	return ((2.443315711809948e-5*zz-1.388731625493765e-3)*zz+4.166664568298827e-2)*zz*zz - 0.5*zz + 1.0
+}
+
+// Sine of a float32. Max error 2 ULP for |x| <= 8192.
+// Larger arguments fall back to math.Sin.
+func Sinf(x float32) float32 {
+	s, _ := Sincosf(x)
+	return s
+}
+
+// Cosine of a float32. Max error 2 ULP for |x| <= 8192.
+// Larger arguments fall back to math.Cos.
+func Cosf(x float32) float32 {
+	_, c := Sincosf(x)
+	return c
+}
+
+// Sine and cosine of a float32. Max error 2 ULP for |x| <= 8192.
+// Larger arguments fall back to math.Sincos.
+func Sincosf(x float32) (sin, cos float32) {
+	ax := x
+	if ax < 0 {
+		ax = -ax
+	}
+	if !(ax <= sinfMaxArg) {
+		if ax != ax || ax > math.MaxFloat32 {
+			nan := float32(math.NaN())
+			return nan, nan
+		}
+		s, c := math.Sincos(float64(x))
+		return float32(s), float32(c)
+	}
+
+	z, j, sign := reducef(ax)
+	s, c := sinPolyf(z), cosPolyf(z)
+	if j == 2 {
+		// A quarter turn: sin(Pi/2+z) = cos(z), cos(Pi/2+z) = -sin(z).
+		s, c = c, -s
+	}
+	if sign {
+		// A half turn negates both.
+		s, c = -s, -c
+	}
+	if x < 0 {
+		s = -s
+	}
+	return s, c
+}
+
+// Arc tangent of y/x of float32 values. Max error 3 ULP.
+func Atan2f(y, x float32) float32 {
+	switch {
+	case y != y || x != x:
+		return float32(math.NaN())
+	case y == 0:
+		if math.Float32bits(x)>>31 == 0 {
+			return y
+		}
+		return copysignf(pi32, y)
+	case x == 0:
+		return copysignf(halfPi32, y)
+	}
+
+	xInf := x > math.MaxFloat32 || x < -math.MaxFloat32
+	yInf := y > math.MaxFloat32 || y < -math.MaxFloat32
+	switch {
+	case xInf && yInf:
+		if x > 0 {
+			return copysignf(quarterPi32, y)
+		}
+		return copysignf(3*quarterPi32, y)
+	case xInf:
+		if x > 0 {
+			return copysignf(0, y)
+		}
+		return copysignf(pi32, y)
+	case yInf:
+		return copysignf(halfPi32, y)
+	}
+
+	q := atanf(y / x)
+	if x < 0 {
+		if y < 0 {
+			return q - pi32
+		}
+		return q + pi32
+	}
+	return q
+}
+
+// Arc tangent of a float32 in [-Pi/2, Pi/2].
+func atanf(x float32) float32 {
+	sign := x < 0
+	if sign {
+		x = -x
+	}
+
+	var y0 float32
+	switch {
+	case x > 2.414213562373095:
+		y0 = halfPi32
+		x = -1.0 / x
+	case x > 0.4142135623730950:
+		y0 = quarterPi32
+		x = (x - 1.0) / (x + 1.0)
+	}
+	z := x * x
+	y := y0 + ((((8.05374449538e-2*z-1.38776856032e-1)*z+1.99777106478e-1)*z-3.33329491539e-1)*z*x + x)
+
+	if sign {
+		return -y
+	}
+	return y
+}
+
+// Arc sine of |x| <= 0.5.
+func asinPolyf(x float32) float32 {
+	z := x * x
+	return ((((4.2163199048e-2*z+2.4181311049e-2)*z+4.5470025998e-2)*z+7.4953002686e-2)*z+1.6666752422e-1)*z*x + x
+}
+
+// Arc cosine of a float32. Max error 2 ULP.
+func Acosf(x float32) float32 {
+	switch {
+	case x != x || x < -1 || x > 1:
+		return float32(math.NaN())
+	case x < -0.5:
+		return pi32 - 2.0*asinPolyf(Sqrtf(0.5*(1.0+x)))
+	case x > 0.5:
+		return 2.0 * asinPolyf(Sqrtf(0.5*(1.0-x)))
+	}
+	return halfPi32 - asinPolyf(x)
+}
+
+// Square root of a float32. Correctly rounded.
+// The conversion pattern compiles to a single precision instruction.
+func Sqrtf(x float32) float32 {
+	return float32(math.Sqrt(float64(x)))
+}
+
+// Reciprocal square root of a float32. Max error 1 ULP.
+// Two hardware instructions beat a bit trick with Newton refinement at this accuracy.
+func Rsqrtf(x float32) float32 {
+	return 1 / Sqrtf(x)
+}
+
+// Multiply x by 2**n in float32, handling overflow and subnormal results.
+func ldexpf(x float32, n int) float32 {
+	for n > 127 {
+		x *= 0x1p127
+		n -= 127
+	}
+	for n < -126 {
+		x *= 0x1p-126
+		n += 126
+	}
+	return x * math.Float32frombits(uint32(n+127)<<23)
+}
+
+func copysignf(x, y float32) float32 {
+	const sign = 1 << 31
+	return math.Float32frombits(math.Float32bits(x)&^sign | math.Float32bits(y)&sign)
+}
+
+// Base-e exponential of a float32. Max error 2 ULP.
+func Expf(x float32) float32 {
+	const (
+		maxLog = 88.72283905206835
+		minLog = -103.278929903431851103
+		log2e  = 1.44269504088896341
+		c1     = 0.693359375
+		c2     = -2.12194440e-4
+	)
+	switch {
+	case x != x:
+		return x
+	case x > maxLog:
+		return float32(math.Inf(+1))
+	case x < minLog:
+		return 0
+	}
+
+	// Round x/ln(2) to the nearest integer n.
+	t := log2e*x + 0.5
+	n := int(t)
+	if float32(n) > t {
+		n--
+	}
+	z := float32(n)
+	x -= z * c1
+	x -= z * c2
+
+	zz := x * x
+	p := (((((1.9875691500e-4*x+1.3981999507e-3)*x+8.3334519073e-3)*x+4.1665795894e-2)*x+1.6666665459e-1)*x+5.0000001201e-1)*zz + x + 1.0
+	return ldexpf(p, n)
+}
+
+// Natural logarithm of a float32. Max error 2 ULP.
+func Logf(x float32) float32 {
+	const sqrtHalf = 0.707106781186547524
+	switch {
+	case x != x || x < 0:
+		return float32(math.NaN())
+	case x == 0:
+		return float32(math.Inf(-1))
+	case x > math.MaxFloat32:
+		return x
+	}
+
+	// Split into mantissa in [0.5, 1) and exponent.
+	e := 0
+	if x < 0x1p-126 {
+		x *= 0x1p25
+		e = -25
+	}
+	bits := math.Float32bits(x)
+	e += int(bits>>23) - 126
+	x = math.Float32frombits(bits&^(0xff<<23) | 126<<23)
+
+	if x < sqrtHalf {
+		e--
+		x = x + x - 1.0
+	} else {
+		x = x - 1.0
+	}
+
+	z := x * x
+	y := ((((((((7.0376836292e-2*x-1.1514610310e-1)*x+1.1676998740e-1)*x-1.2420140846e-1)*x+1.4249322787e-1)*x-1.6668057665e-1)*x+2.0000714765e-1)*x-2.4999993993e-1)*x + 3.3333331174e-1) * x * z
+	fe := float32(e)
+	y += -2.12194440e-4 * fe
+	y += -0.5 * z
+	return x + y + 0.693359375*fe
+}
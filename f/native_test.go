@@ -0,0 +1,177 @@
+package f
+
+import (
+	. "github.com/smartystreets/goconvey/convey"
+	"math"
+	"testing"
+)
+
+// Distance in units in the last place between two float32 values.
+func ulps32(a, b float32) int64 {
+	if a == b {
+		return 0
+	}
+	if a != a || b != b {
+		return math.MaxInt64
+	}
+	key := func(x float32) int64 {
+		i := int64(int32(math.Float32bits(x)))
+		if i < 0 {
+			i = math.MinInt32 - i
+		}
+		return i
+	}
+	d := key(a) - key(b)
+	if d < 0 {
+		d = -d
+	}
+	return d
+}
+
+// Maximum error of fn against the correctly rounded ref over n samples in [lo, hi].
+func maxUlps32(fn func(float32) float32, ref func(float64) float64, lo, hi float32, n int) int64 {
+	var max int64
+	for i := 0; i <= n; i++ {
+		x := lo + (hi-lo)*float32(i)/float32(n)
+		if d := ulps32(fn(x), float32(ref(float64(x)))); d > max {
+			max = d
+		}
+	}
+	return max
+}
+
+func TestNative(test *testing.T) {
+	const n = 200000
+	Convey("Native float32", test, func() {
+		Convey("Sinf and Cosf", func() {
+			e := maxUlps32(Sinf, math.Sin, -8192, 8192, n)
+			test.Logf("Sinf max error %d ULP", e)
+			So(e, ShouldBeLessThanOrEqualTo, 2)
+			e = maxUlps32(Cosf, math.Cos, -8192, 8192, n)
+			test.Logf("Cosf max error %d ULP", e)
+			So(e, ShouldBeLessThanOrEqualTo, 2)
+			e = maxUlps32(Sinf, math.Sin, -1, 1, n)
+			So(e, ShouldBeLessThanOrEqualTo, 2)
+
+			s, c := Sincosf(1)
+			So(s, ShouldEqual, Sinf(1))
+			So(c, ShouldEqual, Cosf(1))
+			So(Sinf(0), ShouldEqual, 0)
+			So(Cosf(0), ShouldEqual, 1)
+			So(Sinf(1e6), ShouldAlmostEqual, math.Sin(1e6), 1e-6)
+			So(math.IsNaN(float64(Sinf(float32(math.Inf(1))))), ShouldBeTrue)
+		})
+		Convey("Atan2f", func() {
+			var max int64
+			for i := 0; i < 4096; i++ {
+				a := 2 * math.Pi * float64(i) / 4096
+				for _, r := range []float64{1e-3, 1, 7.5, 1e4} {
+					y, x := float32(r*math.Sin(a)), float32(r*math.Cos(a))
+					want := float32(math.Atan2(float64(y), float64(x)))
+					if d := ulps32(Atan2f(y, x), want); d > max {
+						max = d
+					}
+				}
+			}
+			test.Logf("Atan2f max error %d ULP", max)
+			So(max, ShouldBeLessThanOrEqualTo, 3)
+
+			inf := float32(math.Inf(1))
+			So(Atan2f(0, 1), ShouldEqual, 0)
+			So(Atan2f(0, -1), ShouldEqual, pi32)
+			So(Atan2f(1, 0), ShouldEqual, halfPi32)
+			So(Atan2f(-1, 0), ShouldEqual, -halfPi32)
+			So(Atan2f(inf, inf), ShouldEqual, quarterPi32)
+			So(Atan2f(1, -inf), ShouldEqual, pi32)
+		})
+		Convey("Acosf", func() {
+			e := maxUlps32(Acosf, math.Acos, -1, 1, n)
+			test.Logf("Acosf max error %d ULP", e)
+			So(e, ShouldBeLessThanOrEqualTo, 2)
+			So(Acosf(1), ShouldEqual, 0)
+			So(math.IsNaN(float64(Acosf(1.5))), ShouldBeTrue)
+		})
+		Convey("Sqrtf and Rsqrtf", func() {
+			e := maxUlps32(Sqrtf, math.Sqrt, 0, 1e6, n)
+			So(e, ShouldEqual, 0)
+
+			rsqrt := func(x float64) float64 { return 1 / math.Sqrt(x) }
+			e = maxUlps32(Rsqrtf, rsqrt, 1e-3, 1e6, n)
+			test.Logf("Rsqrtf max error %d ULP", e)
+			So(e, ShouldBeLessThanOrEqualTo, 1)
+			So(Rsqrtf(4), ShouldEqual, 0.5)
+			So(math.IsInf(float64(Rsqrtf(0)), 1), ShouldBeTrue)
+		})
+		Convey("Expf", func() {
+			e := maxUlps32(Expf, math.Exp, -87, 88, n)
+			test.Logf("Expf max error %d ULP", e)
+			So(e, ShouldBeLessThanOrEqualTo, 2)
+			So(Expf(0), ShouldEqual, 1)
+			So(Expf(-100), ShouldBeGreaterThan, 0)
+			So(math.IsInf(float64(Expf(100)), 1), ShouldBeTrue)
+			So(Expf(-200), ShouldEqual, 0)
+		})
+		Convey("Logf", func() {
+			e := maxUlps32(Logf, math.Log, 1e-3, 1e6, n)
+			test.Logf("Logf max error %d ULP", e)
+			So(e, ShouldBeLessThanOrEqualTo, 2)
+			e = maxUlps32(Logf, math.Log, 0.5, 2, n)
+			So(e, ShouldBeLessThanOrEqualTo, 2)
+			So(Logf(1), ShouldEqual, 0)
+			So(Logf(1e-40), ShouldAlmostEqual, math.Log(float64(float32(1e-40))), 1e-4)
+			So(math.IsInf(float64(Logf(0)), -1), ShouldBeTrue)
+			So(math.IsNaN(float64(Logf(-1))), ShouldBeTrue)
+		})
+	})
+}
+
+var sink32 float32
+var sinkF Float
+
+func BenchmarkSinf(b *testing.B) {
+	for i := 0; i < b.N; i++ {
+		sink32 += Sinf(float32(i&1023) * 0.01)
+	}
+}
+
+func BenchmarkSin(b *testing.B) {
+	for i := 0; i < b.N; i++ {
+		sinkF += Sin(Float(i&1023) * 0.01)
+	}
+}
+
+func BenchmarkAtan2f(b *testing.B) {
+	for i := 0; i < b.N; i++ {
+		sink32 += Atan2f(float32(i&1023)*0.01-5, 1.5)
+	}
+}
+
+func BenchmarkAtan2(b *testing.B) {
+	for i := 0; i < b.N; i++ {
+		sinkF += Atan2(Float(i&1023)*0.01-5, 1.5)
+	}
+}
+
+func BenchmarkExpf(b *testing.B) {
+	for i := 0; i < b.N; i++ {
+		sink32 += Expf(float32(i&1023) * 0.01)
+	}
+}
+
+func BenchmarkExp(b *testing.B) {
+	for i := 0; i < b.N; i++ {
+		sinkF += Exp(Float(i&1023) * 0.01)
+	}
+}
+
+func BenchmarkRsqrtf(b *testing.B) {
+	for i := 0; i < b.N; i++ {
+		sink32 += Rsqrtf(float32(i&1023) + 1)
+	}
+}
+
+func BenchmarkRsqrt(b *testing.B) {
+	for i := 0; i < b.N; i++ {
+		sinkF += 1 / Sqrt(Float(i&1023)+1)
+	}
+}
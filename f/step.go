@@ -0,0 +1,44 @@
+package f
+
+// Hermite interpolation from 0 to 1 as x moves from edge0 to edge1.
+// Clamped outside of the edges.
+func SmoothStep(edge0, edge1, x Float) Float {
+	t := Clamp01((x - edge0) / (edge1 - edge0))
+	return t * t * (3 - 2*t)
+}
+
+// Like SmoothStep but with zero first and second derivatives at the edges.
+func SmootherStep(edge0, edge1, x Float) Float {
+	t := Clamp01((x - edge0) / (edge1 - edge0))
+	return t * t * t * (t*(6*t-15) + 10)
+}
+
+// Returns t such that Lerp(a, b, t) == x. Not clamped.
+func InverseLerp(a, b, x Float) Float {
+	return (x - a) / (b - a)
+}
+
+// Maps x from the range [inMin, inMax] to [outMin, outMax]. Not clamped.
+func Remap(x, inMin, inMax, outMin, outMax Float) Float {
+	return Lerp(outMin, outMax, InverseLerp(inMin, inMax, x))
+}
+
+// Wraps x into the range [min, max).
+func Wrap(x, min, max Float) Float {
+	size := max - min
+	r := Mod(x-min, size)
+	if r < 0 {
+		r += size
+		// A tiny negative r rounds up to size.
+		if r >= size {
+			r = 0
+		}
+	}
+	return min + r
+}
+
+// Bounces t back and forth between 0 and length.
+func PingPong(t, length Float) Float {
+	t = Wrap(t, 0, 2*length)
+	return length - Abs(t-length)
+}
@@ -0,0 +1,111 @@
+package fixed
+
+import "github.com/oniproject/math/aabb"
+import "github.com/oniproject/math/f"
+
+// Fixed-point counterpart of aabb.AABB. (left, bottom, right, top)
+type AABB struct {
+	L, B, R, T Q16
+}
+
+// Convert an aabb.AABB to the nearest fixed-point bounding box.
+func AABBFrom(bb aabb.AABB) AABB {
+	return AABB{
+		Q16FromFloat(float64(bb.L)), Q16FromFloat(float64(bb.B)),
+		Q16FromFloat(float64(bb.R)), Q16FromFloat(float64(bb.T)),
+	}
+}
+
+// Returns bb as an aabb.AABB.
+func (bb AABB) AABB() aabb.AABB {
+	return aabb.New(
+		f.Float(bb.L.Float()), f.Float(bb.B.Float()),
+		f.Float(bb.R.Float()), f.Float(bb.T.Float()),
+	)
+}
+
+// Constructs a AABB centered on a point with the given extents (half sizes).
+func ForExtents(c Vect, hw, hh Q16) AABB {
+	return AABB{c.X - hw, c.Y - hh, c.X + hw, c.Y + hh}
+}
+
+// Constructs a AABB for a circle with the given position and radius.
+func ForCircle(c Vect, r Q16) AABB {
+	return AABB{c.X - r, c.Y - r, c.X + r, c.Y + r}
+}
+
+// Returns true if bb and other intersect.
+func (bb AABB) Intersects(other AABB) bool {
+	return bb.L <= other.R && other.L <= bb.R && bb.B <= other.T && other.B <= bb.T
+}
+
+// Returns true if other lies completely within bb.
+func (bb AABB) Contains(other AABB) bool {
+	return bb.L <= other.L && bb.R >= other.R && bb.B <= other.B && bb.T >= other.T
+}
+
+// Returns true if bb contains p.
+func (bb AABB) ContainsVect(p Vect) bool {
+	return bb.L <= p.X && bb.R >= p.X && bb.B <= p.Y && bb.T >= p.Y
+}
+
+// Returns a bounding box that holds both bounding boxes.
+func (bb AABB) Merge(other AABB) AABB {
+	return AABB{
+		bb.L.Min(other.L), bb.B.Min(other.B),
+		bb.R.Max(other.R), bb.T.Max(other.T),
+	}
+}
+
+// Returns a bounding box that holds both bb and p.
+func (bb AABB) Expand(p Vect) AABB {
+	return AABB{
+		bb.L.Min(p.X), bb.B.Min(p.Y),
+		bb.R.Max(p.X), bb.T.Max(p.Y),
+	}
+}
+
+// Returns the center of a bounding box.
+func (bb AABB) Center() Vect {
+	return Vect{(bb.L + bb.R) / 2, (bb.B + bb.T) / 2}
+}
+
+// Returns the area of the bounding box.
+func (bb AABB) Area() Q16 {
+	return (bb.R - bb.L).Mul(bb.T - bb.B)
+}
+
+// Returns the fraction along the segment query the AABB is hit. Returns Max16 if it doesn't hit.
+func (bb AABB) SegmentQuery(a, b Vect) Q16 {
+	delta := b.Sub(a)
+	tmin, tmax := Min16, Max16
+
+	if delta.X != 0 {
+		t1 := (bb.L - a.X).Div(delta.X)
+		t2 := (bb.R - a.X).Div(delta.X)
+		tmin = tmin.Max(t1.Min(t2))
+		tmax = tmax.Min(t1.Max(t2))
+	}
+
+	if delta.Y != 0 {
+		t1 := (bb.B - a.Y).Div(delta.Y)
+		t2 := (bb.T - a.Y).Div(delta.Y)
+		tmin = tmin.Max(t1.Min(t2))
+		tmax = tmax.Min(t1.Max(t2))
+	}
+
+	if tmin <= tmax && 0 <= tmax && tmin <= One16 {
+		return tmin.Max(0)
+	}
+	return Max16
+}
+
+// Clamp a vector to a bounding box.
+func (bb AABB) ClampVect(p Vect) Vect {
+	return Vect{p.X.Clamp(bb.L, bb.R), p.Y.Clamp(bb.B, bb.T)}
+}
+
+// Returns a bounding box offseted by p.
+func (bb AABB) Offset(p Vect) AABB {
+	return AABB{bb.L + p.X, bb.B + p.Y, bb.R + p.X, bb.T + p.Y}
+}
@@ -0,0 +1,121 @@
+package fixed
+
+import "github.com/oniproject/math/aabb"
+import "github.com/oniproject/math/f"
+
+// Q32 counterpart of AABB. (left, bottom, right, top)
+type AABB32 struct {
+	L, B, R, T Q32
+}
+
+// Convert an aabb.AABB to the nearest Q32 bounding box.
+func AABB32From(bb aabb.AABB) AABB32 {
+	return AABB32{
+		Q32FromFloat(float64(bb.L)), Q32FromFloat(float64(bb.B)),
+		Q32FromFloat(float64(bb.R)), Q32FromFloat(float64(bb.T)),
+	}
+}
+
+// Returns bb as an aabb.AABB.
+func (bb AABB32) AABB() aabb.AABB {
+	return aabb.New(
+		f.Float(bb.L.Float()), f.Float(bb.B.Float()),
+		f.Float(bb.R.Float()), f.Float(bb.T.Float()),
+	)
+}
+
+// Returns bb converted to AABB32. The conversion is exact.
+func (bb AABB) Q32() AABB32 {
+	return AABB32{bb.L.Q32(), bb.B.Q32(), bb.R.Q32(), bb.T.Q32()}
+}
+
+// Returns bb converted to AABB, saturated to the Q16 range.
+func (bb AABB32) Q16() AABB {
+	return AABB{bb.L.Q16(), bb.B.Q16(), bb.R.Q16(), bb.T.Q16()}
+}
+
+// Constructs a AABB32 centered on a point with the given extents (half sizes).
+func ForExtents32(c Vect32, hw, hh Q32) AABB32 {
+	return AABB32{c.X - hw, c.Y - hh, c.X + hw, c.Y + hh}
+}
+
+// Constructs a AABB32 for a circle with the given position and radius.
+func ForCircle32(c Vect32, r Q32) AABB32 {
+	return AABB32{c.X - r, c.Y - r, c.X + r, c.Y + r}
+}
+
+// Returns true if bb and other intersect.
+func (bb AABB32) Intersects(other AABB32) bool {
+	return bb.L <= other.R && other.L <= bb.R && bb.B <= other.T && other.B <= bb.T
+}
+
+// Returns true if other lies completely within bb.
+func (bb AABB32) Contains(other AABB32) bool {
+	return bb.L <= other.L && bb.R >= other.R && bb.B <= other.B && bb.T >= other.T
+}
+
+// Returns true if bb contains p.
+func (bb AABB32) ContainsVect(p Vect32) bool {
+	return bb.L <= p.X && bb.R >= p.X && bb.B <= p.Y && bb.T >= p.Y
+}
+
+// Returns a bounding box that holds both bounding boxes.
+func (bb AABB32) Merge(other AABB32) AABB32 {
+	return AABB32{
+		bb.L.Min(other.L), bb.B.Min(other.B),
+		bb.R.Max(other.R), bb.T.Max(other.T),
+	}
+}
+
+// Returns a bounding box that holds both bb and p.
+func (bb AABB32) Expand(p Vect32) AABB32 {
+	return AABB32{
+		bb.L.Min(p.X), bb.B.Min(p.Y),
+		bb.R.Max(p.X), bb.T.Max(p.Y),
+	}
+}
+
+// Returns the center of a bounding box.
+func (bb AABB32) Center() Vect32 {
+	return Vect32{(bb.L + bb.R) / 2, (bb.B + bb.T) / 2}
+}
+
+// Returns the area of the bounding box.
+func (bb AABB32) Area() Q32 {
+	return (bb.R - bb.L).Mul(bb.T - bb.B)
+}
+
+// Returns the fraction along the segment query the AABB32 is hit. Returns Max32 if it doesn't hit.
+func (bb AABB32) SegmentQuery(a, b Vect32) Q32 {
+	delta := b.Sub(a)
+	tmin, tmax := Min32, Max32
+
+	if delta.X != 0 {
+		t1 := (bb.L - a.X).Div(delta.X)
+		t2 := (bb.R - a.X).Div(delta.X)
+		tmin = tmin.Max(t1.Min(t2))
+		tmax = tmax.Min(t1.Max(t2))
+	}
+
+	if delta.Y != 0 {
+		t1 := (bb.B - a.Y).Div(delta.Y)
+		t2 := (bb.T - a.Y).Div(delta.Y)
+		tmin = tmin.Max(t1.Min(t2))
+		tmax = tmax.Min(t1.Max(t2))
+	}
+
+	if tmin <= tmax && 0 <= tmax && tmin <= One32 {
+		return tmin.Max(0)
+	}
+	return Max32
+}
+
+// Clamp a vector to a bounding box.
+func (bb AABB32) ClampVect(p Vect32) Vect32 {
+	return Vect32{p.X.Clamp(bb.L, bb.R), p.Y.Clamp(bb.B, bb.T)}
+}
+
+// Returns a bounding box offseted by p.
+func (bb AABB32) Offset(p Vect32) AABB32 {
+	return AABB32{bb.L + p.X, bb.B + p.Y, bb.R + p.X, bb.T + p.Y}
+}
@@ -0,0 +1,172 @@
+package fixed
+
+import (
+	"github.com/oniproject/math/aabb"
+	"github.com/oniproject/math/t"
+	"github.com/oniproject/math/v"
+	. "github.com/smartystreets/goconvey/convey"
+	"math"
+	"testing"
+)
+
+func TestFixed(test *testing.T) {
+	Convey("Fixed", test, func() {
+		Convey("Conversion", func() {
+			So(Q16FromInt(3), ShouldEqual, 3*One16)
+			So(Q16FromFloat(-2.5).Float(), ShouldEqual, -2.5)
+			So(Q16FromFloat(-2.5).Int(), ShouldEqual, -3)
+			So(Q32FromFloat(math.Pi), ShouldEqual, Pi32)
+			So(Q16FromFloat(math.Pi), ShouldEqual, Pi16)
+			So(Pi16.Q32().Q16(), ShouldEqual, Pi16)
+			So(Q32FromInt(1<<20).Q16(), ShouldEqual, Max16)
+		})
+
+		Convey("Arithmetic", func() {
+			a, b := Q16FromFloat(1.5), Q16FromFloat(-2.25)
+			So(a.Mul(b).Float(), ShouldEqual, -3.375)
+			So(b.Div(a).Float(), ShouldEqual, -1.5)
+			So(Max16.Mul(a), ShouldEqual, Max16)
+			So(a.Div(0), ShouldEqual, Max16)
+
+			x, y := Q32FromFloat(1.5), Q32FromFloat(-2.25)
+			So(x.Mul(y).Float(), ShouldEqual, -3.375)
+			So(y.Div(x).Float(), ShouldEqual, -1.5)
+			So(Max32.Mul(x), ShouldEqual, Max32)
+
+			So(b.Floor().Float(), ShouldEqual, -3)
+			So(b.Ceil().Float(), ShouldEqual, -2)
+			So(a.Floor().Float(), ShouldEqual, 1)
+			So(a.Ceil().Float(), ShouldEqual, 2)
+			So(Q16FromFloat(5.5).Mod(Q16FromInt(2)).Float(), ShouldEqual, 1.5)
+			So(Q16FromFloat(-5.5).Mod(Q16FromInt(2)).Float(), ShouldEqual, -1.5)
+		})
+
+		Convey("Sqrt", func() {
+			So(Q16FromInt(9).Sqrt(), ShouldEqual, Q16FromInt(3))
+			So(Q32FromInt(2).Sqrt().Float(), ShouldAlmostEqual, math.Sqrt2, 1e-9)
+			So(Q32FromInt(-2).Sqrt(), ShouldEqual, 0)
+			So(Max32.Sqrt().Float(), ShouldAlmostEqual, math.Sqrt(Max32.Float()), 1e-6)
+		})
+
+		Convey("Trigonometry", func() {
+			for x := -10.0; x <= 10.0; x += 0.01 {
+				s, c := Q32FromFloat(x).Sincos()
+				So(s.Float(), ShouldAlmostEqual, math.Sin(x), 1e-8)
+				So(c.Float(), ShouldAlmostEqual, math.Cos(x), 1e-8)
+				So(Q16FromFloat(x).Sin().Float(), ShouldAlmostEqual, math.Sin(Q16FromFloat(x).Float()), 2e-5)
+			}
+			for a := -math.Pi + 0.01; a < math.Pi; a += 0.01 {
+				y, x := Q32FromFloat(3*math.Sin(a)), Q32FromFloat(3*math.Cos(a))
+				So(y.Atan2(x).Float(), ShouldAlmostEqual, math.Atan2(y.Float(), x.Float()), 1e-8)
+			}
+			So(Q32FromInt(0).Atan2(Q32FromInt(-1)).Float(), ShouldAlmostEqual, math.Pi, 1e-8)
+			for x := -1.0; x <= 1.0; x += 0.01 {
+				So(Q32FromFloat(x).Acos().Float(), ShouldAlmostEqual, math.Acos(x), 1e-4)
+			}
+			So(One32.Acos(), ShouldEqual, 0)
+			So((-One16).Acos(), ShouldEqual, Pi16)
+		})
+
+		Convey("Exp", func() {
+			for x := -20.0; x <= 20.0; x += 0.1 {
+				want := math.Exp(x)
+				So(Q32FromFloat(x).Exp().Float(), ShouldAlmostEqual, want, want*1e-7+1e-9)
+			}
+			So(Q16FromInt(20).Exp(), ShouldEqual, Max16)
+			So(Q16FromInt(-20).Exp(), ShouldEqual, 0)
+		})
+
+		Convey("Deterministic", func() {
+			// Golden values, identical on every platform.
+			So(One16.Sin(), ShouldEqual, Q16(55147))
+			So(One16.Cos(), ShouldEqual, Q16(35409))
+			So(One16.Exp(), ShouldEqual, Q16(178145))
+		})
+	})
+}
+
+func TestGeometry(test *testing.T) {
+	Convey("Fixed geometry", test, func() {
+		Convey("Vect", func() {
+			p := VectFrom(v.V(3, 4))
+			So(p.Length(), ShouldEqual, Q16FromInt(5))
+			n := p.Normalize().Vect()
+			So(n.X, ShouldAlmostEqual, 0.6, 1e-4)
+			So(n.Y, ShouldAlmostEqual, 0.8, 1e-4)
+			So(p.Add(p).Vect(), ShouldResemble, v.V(6, 8))
+			So(p.Dot(p), ShouldEqual, Q16FromInt(25))
+			So(p.Cross(p.LPerp()), ShouldEqual, Q16FromInt(25))
+			So(ForAngle(Pi16/2).ToAngle(), ShouldAlmostEqual, Pi16/2, 2)
+		})
+
+		Convey("AABB", func() {
+			bb := AABBFrom(aabb.New(0, 0, 6, 6))
+			So(bb.AABB(), ShouldResemble, aabb.New(0, 0, 6, 6))
+			So(bb.Intersects(AABBFrom(aabb.New(-1, -1, 3, 3))), ShouldBeTrue)
+			So(bb.ContainsVect(VectFrom(v.V(7, 1))), ShouldBeFalse)
+			So(bb.Merge(AABBFrom(aabb.New(-1, -2, 3, 4))).AABB(), ShouldResemble, aabb.New(-1, -2, 6, 6))
+			So(bb.SegmentQuery(VectFrom(v.V(-3, 3)), VectFrom(v.V(3, 3))), ShouldEqual, One16/2)
+			So(bb.SegmentQuery(VectFrom(v.V(-3, 9)), VectFrom(v.V(3, 12))), ShouldEqual, Max16)
+		})
+
+		Convey("Transform", func() {
+			m := Rigid(VectFrom(v.V(2, 3)), Pi16/2)
+			p := m.Point(VectFrom(v.V(1, 0))).Vect()
+			So(p.X, ShouldAlmostEqual, 2, 1e-4)
+			So(p.Y, ShouldAlmostEqual, 4, 1e-4)
+
+			q := m.Inverse().Point(m.Point(VectFrom(v.V(-1, 8)))).Vect()
+			So(q.X, ShouldAlmostEqual, -1, 1e-3)
+			So(q.Y, ShouldAlmostEqual, 8, 1e-3)
+
+			s := TransformFrom(t.Scale(2, 3))
+			So(s.Transform(), ShouldResemble, t.Scale(2, 3))
+			So(s.Mult(Identity()), ShouldResemble, s)
+			So(s.BB(AABBFrom(aabb.New(-1, -1, 1, 1))).AABB(), ShouldResemble, aabb.New(-2, -3, 2, 3))
+		})
+
+		Convey("Vect32", func() {
+			p := Vect32From(v.V(3, 4))
+			So(p.Length(), ShouldEqual, Q32FromInt(5))
+			So(p.Normalize().X.Float(), ShouldAlmostEqual, 0.6, 1e-9)
+			So(p.Cross(p.LPerp()), ShouldEqual, Q32FromInt(25))
+			So(ForAngle32(Pi32/2).ToAngle().Float(), ShouldAlmostEqual, math.Pi/2, 1e-8)
+			So(VectFrom(v.V(3, 4)).Q32(), ShouldResemble, p)
+			So(p.Q16(), ShouldResemble, VectFrom(v.V(3, 4)))
+
+			// The squared length overflows Q32, the length does not.
+			big := V32(Q32FromInt(3e8), Q32FromInt(4e8))
+			So(big.Length(), ShouldEqual, Q32FromInt(5e8))
+			So(V32(Max32, Max32).Length(), ShouldEqual, Max32)
+		})
+
+		Convey("AABB32", func() {
+			bb := AABB32From(aabb.New(0, 0, 6, 6))
+			So(bb.AABB(), ShouldResemble, aabb.New(0, 0, 6, 6))
+			So(bb.Q16().Q32(), ShouldResemble, bb)
+			So(bb.Intersects(AABB32From(aabb.New(-1, -1, 3, 3))), ShouldBeTrue)
+			So(bb.ContainsVect(Vect32From(v.V(7, 1))), ShouldBeFalse)
+			So(bb.Merge(AABB32From(aabb.New(-1, -2, 3, 4))).AABB(), ShouldResemble, aabb.New(-1, -2, 6, 6))
+			So(bb.SegmentQuery(Vect32From(v.V(-3, 3)), Vect32From(v.V(3, 3))), ShouldEqual, One32/2)
+			So(bb.SegmentQuery(Vect32From(v.V(-3, 9)), Vect32From(v.V(3, 12))), ShouldEqual, Max32)
+		})
+
+		Convey("Transform32", func() {
+			m := Rigid32(Vect32From(v.V(2, 3)), Pi32/2)
+			p := m.Point(Vect32From(v.V(1, 0))).Vect()
+			So(p.X, ShouldAlmostEqual, 2, 1e-6)
+			So(p.Y, ShouldAlmostEqual, 4, 1e-6)
+
+			q := m.Inverse().Point(m.Point(Vect32From(v.V(-1, 8)))).Vect()
+			So(q.X, ShouldAlmostEqual, -1, 1e-6)
+			So(q.Y, ShouldAlmostEqual, 8, 1e-6)
+
+			s := Transform32From(t.Scale(2, 3))
+			So(s.Transform(), ShouldResemble, t.Scale(2, 3))
+			So(s.Mult(Identity32()), ShouldResemble, s)
+			So(TransformFrom(t.Scale(2, 3)).Q32(), ShouldResemble, s)
+			So(s.Q16(), ShouldResemble, TransformFrom(t.Scale(2, 3)))
+			So(s.BB(AABB32From(aabb.New(-1, -1, 1, 1))).AABB(), ShouldResemble, aabb.New(-2, -3, 2, 3))
+		})
+	})
+}
@@ -0,0 +1,128 @@
+package fixed
+
+// Signed Q16.16 fixed-point number.
+// Transcendental functions are evaluated in Q32 and rounded back.
+type Q16 int32
+
+const (
+	One16 Q16 = 1 << 16
+	Pi16  Q16 = 205887
+	Max16 Q16 = 1<<31 - 1
+	Min16 Q16 = -1 << 31
+)
+
+// Convert an integer to Q16.
+func Q16FromInt(i int) Q16 { return Q16(int32(i) << 16) }
+
+// Convert a float to the nearest Q16. Only use it for constants and input,
+// the conversion itself is exact and portable.
+func Q16FromFloat(x float64) Q16 {
+	x *= float64(One16)
+	if x < 0 {
+		return Q16(x - 0.5)
+	}
+	return Q16(x + 0.5)
+}
+
+// Returns the integer part, rounded towards negative infinity.
+func (x Q16) Int() int { return int(x >> 16) }
+
+// Returns x as a float.
+func (x Q16) Float() float64 { return float64(x) / float64(One16) }
+
+// Returns x converted to Q32. The conversion is exact.
+func (x Q16) Q32() Q32 { return Q32(int64(x) << 16) }
+
+func saturate16(x int64) Q16 {
+	switch {
+	case x > int64(Max16):
+		return Max16
+	case x < int64(Min16):
+		return Min16
+	}
+	return Q16(x)
+}
+
+// Returns x*y rounded to nearest and saturated on overflow.
+func (x Q16) Mul(y Q16) Q16 {
+	return saturate16((int64(x)*int64(y) + 1<<15) >> 16)
+}
+
+// Returns x/y truncated towards zero and saturated on overflow or division by zero.
+func (x Q16) Div(y Q16) Q16 {
+	if y == 0 {
+		if x < 0 {
+			return Min16
+		}
+		return Max16
+	}
+	return saturate16((int64(x) << 16) / int64(y))
+}
+
+// Returns the absolute value of x.
+func (x Q16) Abs() Q16 {
+	if x < 0 {
+		return -x
+	}
+	return x
+}
+
+// Returns the greatest integer value less than or equal to x.
+func (x Q16) Floor() Q16 { return x >> 16 << 16 }
+
+// Returns the least integer value greater than or equal to x.
+func (x Q16) Ceil() Q16 { return -(-x).Floor() }
+
+// Returns the remainder of x/y with the sign of x, like math.Mod.
+func (x Q16) Mod(y Q16) Q16 {
+	if y == 0 {
+		return 0
+	}
+	return x % y
+}
+
+// Returns the square root of x, or 0 for negative x.
+func (x Q16) Sqrt() Q16 { return x.Q32().Sqrt().Q16() }
+
+// Returns the sine and cosine of x.
+func (x Q16) Sincos() (sin, cos Q16) {
+	s, c := x.Q32().Sincos()
+	return s.Q16(), c.Q16()
+}
+
+// Returns the sine of x.
+func (x Q16) Sin() Q16 { return x.Q32().Sin().Q16() }
+
+// Returns the cosine of x.
+func (x Q16) Cos() Q16 { return x.Q32().Cos().Q16() }
+
+// Returns the arc tangent of y/x using the signs of both to determine the quadrant.
+func (y Q16) Atan2(x Q16) Q16 { return y.Q32().Atan2(x.Q32()).Q16() }
+
+// Returns the arc cosine of x, clamped to [-1, 1].
+func (x Q16) Acos() Q16 { return x.Q32().Acos().Q16() }
+
+// Returns e**x, saturated on overflow.
+func (x Q16) Exp() Q16 { return x.Q32().Exp().Q16() }
+
+// Returns the smaller of x and y.
+func (x Q16) Min(y Q16) Q16 {
+	if x < y {
+		return x
+	}
+	return y
+}
+
+// Returns the larger of x and y.
+func (x Q16) Max(y Q16) Q16 {
+	if x > y {
+		return x
+	}
+	return y
+}
+
+// Clamp x to be between min and max.
+func (x Q16) Clamp(min, max Q16) Q16 { return x.Max(min).Min(max) }
+
+// Linearly interpolate between x and y by t.
+func (x Q16) Lerp(y, t Q16) Q16 { return x + (y - x).Mul(t) }
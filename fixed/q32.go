@@ -0,0 +1,317 @@
+// Deterministic fixed-point numbers and geometry.
+//
+// All operations use integer arithmetic only, so results are bit-identical
+// on every platform. Transcendental functions use CORDIC and series with
+// constant tables and are accurate to about 1e-8 in Q32 precision.
+//
+// Vect, AABB and Transform use Q16; Vect32, AABB32 and Transform32 are
+// their Q32 counterparts for a wider range and finer precision.
+package fixed
+
+import "math/bits"
+
+// Signed Q32.32 fixed-point number.
+type Q32 int64
+
+const (
+	One32 Q32 = 1 << 32
+	Pi32  Q32 = 13493037705
+	Max32 Q32 = 1<<63 - 1
+	Min32 Q32 = -1 << 63
+
+	halfPi32 Q32 = Pi32 / 2
+	twoPi32  Q32 = Pi32 * 2
+	ln2_32   Q32 = 2977044472
+	// Gain of 32 CORDIC iterations, 1/prod(sqrt(1 + 2^-2i)).
+	cordicK32 = 2608131496
+)
+
+// atan(2^-i) in Q32.
+var cordicAtan = [32]int64{
+	3373259426, 1991351318, 1052175346, 534100635, 268086748, 134174063,
+	67103403, 33553749, 16777131, 8388597, 4194303, 2097152, 1048576,
+	524288, 262144, 131072, 65536, 32768, 16384, 8192, 4096, 2048, 1024,
+	512, 256, 128, 64, 32, 16, 8, 4, 2,
+}
+
+// Convert an integer to Q32.
+func Q32FromInt(i int) Q32 { return Q32(int64(i) << 32) }
+
+// Convert a float to the nearest Q32. Only use it for constants and input,
+// the conversion itself is exact and portable.
+func Q32FromFloat(x float64) Q32 {
+	x *= float64(One32)
+	if x < 0 {
+		return Q32(x - 0.5)
+	}
+	return Q32(x + 0.5)
+}
+
+// Returns the integer part, rounded towards negative infinity.
+func (x Q32) Int() int { return int(x >> 32) }
+
+// Returns x as a float.
+func (x Q32) Float() float64 { return float64(x) / float64(One32) }
+
+// Returns x converted to Q16, saturated to the Q16 range.
+func (x Q32) Q16() Q16 {
+	r := (int64(x) + 1<<15) >> 16
+	switch {
+	case r > int64(Max16):
+		return Max16
+	case r < int64(Min16):
+		return Min16
+	}
+	return Q16(r)
+}
+
+func abs64(x int64) uint64 {
+	if x < 0 {
+		return uint64(-x)
+	}
+	return uint64(x)
+}
+
+// Applies a sign to a magnitude, saturating on overflow.
+func signed64(u uint64, neg bool) Q32 {
+	if u > 1<<63-1 {
+		if neg {
+			return Min32
+		}
+		return Max32
+	}
+	if neg {
+		return -Q32(u)
+	}
+	return Q32(u)
+}
+
+// Returns x*y rounded to nearest and saturated on overflow.
+func (x Q32) Mul(y Q32) Q32 {
+	neg := (x < 0) != (y < 0)
+	hi, lo := bits.Mul64(abs64(int64(x)), abs64(int64(y)))
+	lo, carry := bits.Add64(lo, 1<<31, 0)
+	hi += carry
+	if hi >= 1<<32 {
+		return signed64(1<<64-1, neg)
+	}
+	return signed64(hi<<32|lo>>32, neg)
+}
+
+// Returns x/y truncated towards zero and saturated on overflow or division by zero.
+func (x Q32) Div(y Q32) Q32 {
+	neg := (x < 0) != (y < 0)
+	ux, uy := abs64(int64(x)), abs64(int64(y))
+	hi, lo := ux>>32, ux<<32
+	if hi >= uy {
+		return signed64(1<<64-1, neg)
+	}
+	q, _ := bits.Div64(hi, lo, uy)
+	return signed64(q, neg)
+}
+
+// Returns the absolute value of x.
+func (x Q32) Abs() Q32 {
+	if x < 0 {
+		return -x
+	}
+	return x
+}
+
+// Returns the greatest integer value less than or equal to x.
+func (x Q32) Floor() Q32 { return x >> 32 << 32 }
+
+// Returns the least integer value greater than or equal to x.
+func (x Q32) Ceil() Q32 { return -(-x).Floor() }
+
+// Returns the remainder of x/y with the sign of x, like math.Mod.
+func (x Q32) Mod(y Q32) Q32 {
+	if y == 0 {
+		return 0
+	}
+	return x % y
+}
+
+// Integer square root of a 128-bit number using Newton's method.
+func isqrt128(hi, lo uint64) uint64 {
+	if hi == 0 && lo == 0 {
+		return 0
+	}
+	n := 128 - bits.LeadingZeros64(hi)
+	if hi == 0 {
+		n = 64 - bits.LeadingZeros64(lo)
+	}
+	// Initial guess is a power of two not smaller than the root.
+	r := uint64(1) << uint((n+1)/2)
+	if (n+1)/2 >= 64 {
+		r = 1<<64 - 1
+	}
+	for {
+		q, _ := bits.Div64(hi, lo, r)
+		sum, carry := bits.Add64(r, q, 0)
+		next := sum>>1 | carry<<63
+		if next >= r {
+			return r
+		}
+		r = next
+	}
+}
+
+// Returns the square root of x, or 0 for negative x.
+func (x Q32) Sqrt() Q32 {
+	if x <= 0 {
+		return 0
+	}
+	u := uint64(x)
+	return Q32(isqrt128(u>>32, u<<32))
+}
+
+// CORDIC in rotation mode for z in [-Pi/2, Pi/2].
+func cordicRotate(z int64) (cos, sin int64) {
+	x, y := int64(cordicK32), int64(0)
+	for i := uint(0); i < 32; i++ {
+		dx, dy := y>>i, x>>i
+		if z >= 0 {
+			x, y, z = x-dx, y+dy, z-cordicAtan[i]
+		} else {
+			x, y, z = x+dx, y-dy, z+cordicAtan[i]
+		}
+	}
+	return x, y
+}
+
+// Returns the sine and cosine of x.
+func (x Q32) Sincos() (sin, cos Q32) {
+	// Reduce to [-Pi, Pi).
+	z := int64((x%twoPi32+twoPi32+Pi32)%twoPi32 - Pi32)
+
+	neg := false
+	switch {
+	case z > int64(halfPi32):
+		z, neg = int64(Pi32)-z, true
+	case z < -int64(halfPi32):
+		z, neg = -int64(Pi32)-z, true
+	}
+	c, s := cordicRotate(z)
+	if neg {
+		c = -c
+	}
+	return Q32(s), Q32(c)
+}
+
+// Returns the sine of x.
+func (x Q32) Sin() Q32 {
+	s, _ := x.Sincos()
+	return s
+}
+
+// Returns the cosine of x.
+func (x Q32) Cos() Q32 {
+	_, c := x.Sincos()
+	return c
+}
+
+// Returns the arc tangent of y/x using the signs of both to determine the quadrant.
+func (y Q32) Atan2(x Q32) Q32 {
+	if x == 0 && y == 0 {
+		return 0
+	}
+
+	var z int64
+	xi, yi := int64(x), int64(y)
+	if xi < 0 {
+		// Rotate by Pi into the right half plane.
+		xi, yi = -xi, -yi
+		if y >= 0 {
+			z = int64(Pi32)
+		} else {
+			z = -int64(Pi32)
+		}
+	}
+
+	// Scale up for precision, leaving headroom for the CORDIC gain.
+	m := abs64(xi) | abs64(yi)
+	if s := bits.LeadingZeros64(m) - 3; s > 0 {
+		xi, yi = xi<<uint(s), yi<<uint(s)
+	} else if s < 0 {
+		xi, yi = xi>>uint(-s), yi>>uint(-s)
+	}
+
+	// CORDIC in vectoring mode.
+	for i := uint(0); i < 32; i++ {
+		dx, dy := yi>>i, xi>>i
+		if yi > 0 {
+			xi, yi, z = xi+dx, yi-dy, z+cordicAtan[i]
+		} else {
+			xi, yi, z = xi-dx, yi+dy, z-cordicAtan[i]
+		}
+	}
+	return Q32(z)
+}
+
+// Returns the arc cosine of x, clamped to [-1, 1].
+func (x Q32) Acos() Q32 {
+	if x >= One32 {
+		return 0
+	}
+	if x <= -One32 {
+		return Pi32
+	}
+	return (One32 - x.Mul(x)).Sqrt().Atan2(x)
+}
+
+// Returns e**x, saturated on overflow.
+func (x Q32) Exp() Q32 {
+	switch {
+	case x == 0:
+		return One32
+	case x > 22*One32:
+		return Max32
+	case x < -23*One32:
+		return 0
+	}
+
+	// x = k*ln2 + r with |r| <= ln2/2.
+	k := (x + ln2_32/2).Div(ln2_32).Floor() >> 32
+	r := x - Q32(k)*ln2_32
+
+	// Taylor series of e**r.
+	sum, term := One32, One32
+	for n := Q32(1); n < 16; n++ {
+		term = term.Mul(r) / n
+		if term == 0 {
+			break
+		}
+		sum += term
+	}
+
+	if k >= 0 {
+		if k >= 31 || sum > Max32>>uint(k) {
+			return Max32
+		}
+		return sum << uint(k)
+	}
+	return (sum + 1<<uint(-k-1)) >> uint(-k)
+}
+
+// Returns the smaller of x and y.
+func (x Q32) Min(y Q32) Q32 {
+	if x < y {
+		return x
+	}
+	return y
+}
+
+// Returns the larger of x and y.
+func (x Q32) Max(y Q32) Q32 {
+	if x > y {
+		return x
+	}
+	return y
+}
+
+// Clamp x to be between min and max.
+func (x Q32) Clamp(min, max Q32) Q32 { return x.Max(min).Min(max) }
+
+// Linearly interpolate between x and y by t.
+func (x Q32) Lerp(y, t Q32) Q32 { return x + (y - x).Mul(t) }
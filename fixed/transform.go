@@ -0,0 +1,101 @@
+package fixed
+
+import "github.com/oniproject/math/f"
+import "github.com/oniproject/math/t"
+
+// Fixed-point counterpart of t.Transform.
+type Transform struct {
+	A, B, C, D, Tx, Ty Q16
+}
+
+// Convert a t.Transform to the nearest fixed-point transform.
+func TransformFrom(m t.Transform) Transform {
+	q := func(x f.Float) Q16 { return Q16FromFloat(float64(x)) }
+	return Transform{q(m.A), q(m.B), q(m.C), q(m.D), q(m.Tx), q(m.Ty)}
+}
+
+// Returns m as a t.Transform.
+func (m Transform) Transform() t.Transform {
+	g := func(x Q16) f.Float { return f.Float(x.Float()) }
+	return t.New(g(m.A), g(m.B), g(m.C), g(m.D), g(m.Tx), g(m.Ty))
+}
+
+// Identity transform matrix.
+func Identity() Transform {
+	return Transform{One16, 0, 0, One16, 0, 0}
+}
+
+// Transform an absolute point. (i.e. a vertex)
+func (m Transform) Point(p Vect) Vect {
+	return Vect{
+		m.A.Mul(p.X) + m.C.Mul(p.Y) + m.Tx,
+		m.B.Mul(p.X) + m.D.Mul(p.Y) + m.Ty,
+	}
+}
+
+// Transform a vector (i.e. a normal)
+func (m Transform) Vect(p Vect) Vect {
+	return Vect{
+		m.A.Mul(p.X) + m.C.Mul(p.Y),
+		m.B.Mul(p.X) + m.D.Mul(p.Y),
+	}
+}
+
+// Get the inverse of a transform matrix.
+func (m Transform) Inverse() Transform {
+	det := m.A.Mul(m.D) - m.C.Mul(m.B)
+	return Transform{
+		A:  m.D.Div(det),
+		B:  -m.B.Div(det),
+		C:  -m.C.Div(det),
+		D:  m.A.Div(det),
+		Tx: (m.C.Mul(m.Ty) - m.Tx.Mul(m.D)).Div(det),
+		Ty: (m.Tx.Mul(m.B) - m.A.Mul(m.Ty)).Div(det),
+	}
+}
+
+// Multiply two transformation matrices.
+func (m Transform) Mult(o Transform) Transform {
+	return Transform{
+		A:  m.A.Mul(o.A) + m.C.Mul(o.B),
+		B:  m.B.Mul(o.A) + m.D.Mul(o.B),
+		C:  m.A.Mul(o.C) + m.C.Mul(o.D),
+		D:  m.B.Mul(o.C) + m.D.Mul(o.D),
+		Tx: m.A.Mul(o.Tx) + m.C.Mul(o.Ty) + m.Tx,
+		Ty: m.B.Mul(o.Tx) + m.D.Mul(o.Ty) + m.Ty,
+	}
+}
+
+// Transform a bounding box.
+func (m Transform) BB(bb AABB) AABB {
+	center := bb.Center()
+	hw := (bb.R - bb.L) / 2
+	hh := (bb.T - bb.B) / 2
+
+	a, b, d, e := m.A.Mul(hw), m.C.Mul(hh), m.B.Mul(hw), m.D.Mul(hh)
+	hwMax := (a + b).Abs().Max((a - b).Abs())
+	hhMax := (d + e).Abs().Max((d - e).Abs())
+	return ForExtents(m.Point(center), hwMax, hhMax)
+}
+
+// Create a transation matrix.
+func Translate(p Vect) Transform {
+	return Transform{One16, 0, 0, One16, p.X, p.Y}
+}
+
+// Create a scale matrix.
+func Scale(x, y Q16) Transform {
+	return Transform{x, 0, 0, y, 0, 0}
+}
+
+// Create a rotation matrix.
+func Rotate(radians Q16) Transform {
+	rot := ForAngle(radians)
+	return Transform{rot.X, rot.Y, -rot.Y, rot.X, 0, 0}
+}
+
+// Create a rigid transformation matrix. (transation + rotation)
+func Rigid(p Vect, radians Q16) Transform {
+	rot := ForAngle(radians)
+	return Transform{rot.X, rot.Y, -rot.Y, rot.X, p.X, p.Y}
+}
@@ -0,0 +1,111 @@
+package fixed
+
+import "github.com/oniproject/math/f"
+import "github.com/oniproject/math/t"
+
+// Q32 counterpart of Transform.
+type Transform32 struct {
+	A, B, C, D, Tx, Ty Q32
+}
+
+// Convert a t.Transform to the nearest Q32 transform.
+func Transform32From(m t.Transform) Transform32 {
+	q := func(x f.Float) Q32 { return Q32FromFloat(float64(x)) }
+	return Transform32{q(m.A), q(m.B), q(m.C), q(m.D), q(m.Tx), q(m.Ty)}
+}
+
+// Returns m as a t.Transform.
+func (m Transform32) Transform() t.Transform {
+	g := func(x Q32) f.Float { return f.Float(x.Float()) }
+	return t.New(g(m.A), g(m.B), g(m.C), g(m.D), g(m.Tx), g(m.Ty))
+}
+
+// Returns m converted to Transform32. The conversion is exact.
+func (m Transform) Q32() Transform32 {
+	return Transform32{m.A.Q32(), m.B.Q32(), m.C.Q32(), m.D.Q32(), m.Tx.Q32(), m.Ty.Q32()}
+}
+
+// Returns m converted to Transform, saturated to the Q16 range.
+func (m Transform32) Q16() Transform {
+	return Transform{m.A.Q16(), m.B.Q16(), m.C.Q16(), m.D.Q16(), m.Tx.Q16(), m.Ty.Q16()}
+}
+
+// Identity transform matrix.
+func Identity32() Transform32 {
+	return Transform32{One32, 0, 0, One32, 0, 0}
+}
+
+// Transform an absolute point. (i.e. a vertex)
+func (m Transform32) Point(p Vect32) Vect32 {
+	return Vect32{
+		m.A.Mul(p.X) + m.C.Mul(p.Y) + m.Tx,
+		m.B.Mul(p.X) + m.D.Mul(p.Y) + m.Ty,
+	}
+}
+
+// Transform a vector (i.e. a normal)
+func (m Transform32) Vect(p Vect32) Vect32 {
+	return Vect32{
+		m.A.Mul(p.X) + m.C.Mul(p.Y),
+		m.B.Mul(p.X) + m.D.Mul(p.Y),
+	}
+}
+
+// Get the inverse of a transform matrix.
+func (m Transform32) Inverse() Transform32 {
+	det := m.A.Mul(m.D) - m.C.Mul(m.B)
+	return Transform32{
+		A:  m.D.Div(det),
+		B:  -m.B.Div(det),
+		C:  -m.C.Div(det),
+		D:  m.A.Div(det),
+		Tx: (m.C.Mul(m.Ty) - m.Tx.Mul(m.D)).Div(det),
+		Ty: (m.Tx.Mul(m.B) - m.A.Mul(m.Ty)).Div(det),
+	}
+}
+
+// Multiply two transformation matrices.
+func (m Transform32) Mult(o Transform32) Transform32 {
+	return Transform32{
+		A:  m.A.Mul(o.A) + m.C.Mul(o.B),
+		B:  m.B.Mul(o.A) + m.D.Mul(o.B),
+		C:  m.A.Mul(o.C) + m.C.Mul(o.D),
+		D:  m.B.Mul(o.C) + m.D.Mul(o.D),
+		Tx: m.A.Mul(o.Tx) + m.C.Mul(o.Ty) + m.Tx,
+		Ty: m.B.Mul(o.Tx) + m.D.Mul(o.Ty) + m.Ty,
+	}
+}
+
+// Transform a bounding box.
+func (m Transform32) BB(bb AABB32) AABB32 {
+	center := bb.Center()
+	hw := (bb.R - bb.L) / 2
+	hh := (bb.T - bb.B) / 2
+
+	a, b, d, e := m.A.Mul(hw), m.C.Mul(hh), m.B.Mul(hw), m.D.Mul(hh)
+	hwMax := (a + b).Abs().Max((a - b).Abs())
+	hhMax := (d + e).Abs().Max((d - e).Abs())
+	return ForExtents32(m.Point(center), hwMax, hhMax)
+}
+
+// Create a transation matrix.
+func Translate32(p Vect32) Transform32 {
+	return Transform32{One32, 0, 0, One32, p.X, p.Y}
+}
+
+// Create a scale matrix.
+func Scale32(x, y Q32) Transform32 {
+	return Transform32{x, 0, 0, y, 0, 0}
+}
+
+// Create a rotation matrix.
+func Rotate32(radians Q32) Transform32 {
+	rot := ForAngle32(radians)
+	return Transform32{rot.X, rot.Y, -rot.Y, rot.X, 0, 0}
+}
+
+// Create a rigid transformation matrix. (transation + rotation)
+func Rigid32(p Vect32, radians Q32) Transform32 {
+	rot := ForAngle32(radians)
+	return Transform32{rot.X, rot.Y, -rot.Y, rot.X, p.X, p.Y}
+}
@@ -0,0 +1,103 @@
+package fixed
+
+import "github.com/oniproject/math/f"
+import "github.com/oniproject/math/v"
+
+// Fixed-point counterpart of v.Vect.
+type Vect struct{ X, Y Q16 }
+
+// Convenience constructor for Vect structs.
+func V(x, y Q16) Vect { return Vect{x, y} }
+
+// Convert a v.Vect to the nearest fixed-point vector.
+func VectFrom(p v.Vect) Vect {
+	return Vect{Q16FromFloat(float64(p.X)), Q16FromFloat(float64(p.Y))}
+}
+
+// Returns p as a v.Vect.
+func (p Vect) Vect() v.Vect {
+	return v.V(f.Float(p.X.Float()), f.Float(p.Y.Float()))
+}
+
+// Add two vectors.
+func (p Vect) Add(q Vect) Vect { return Vect{p.X + q.X, p.Y + q.Y} }
+
+// Subtract two vectors.
+func (p Vect) Sub(q Vect) Vect { return Vect{p.X - q.X, p.Y - q.Y} }
+
+// Negate a vector.
+func (p Vect) Neg() Vect { return Vect{-p.X, -p.Y} }
+
+// Scalar multiplication.
+func (p Vect) Mult(s Q16) Vect { return Vect{p.X.Mul(s), p.Y.Mul(s)} }
+
+// Vector dot product.
+func (p Vect) Dot(q Vect) Q16 { return p.X.Mul(q.X) + p.Y.Mul(q.Y) }
+
+// 2D vector cross product analog.
+func (p Vect) Cross(q Vect) Q16 { return p.X.Mul(q.Y) - p.Y.Mul(q.X) }
+
+// Returns a perpendicular vector. (90 degree rotation)
+func (p Vect) LPerp() Vect { return Vect{-p.Y, p.X} }
+
+// Returns a perpendicular vector. (-90 degree rotation)
+func (p Vect) RPerp() Vect { return Vect{p.Y, -p.X} }
+
+// Uses complex number multiplication to rotate p by q.
+func (p Vect) Rotate(q Vect) Vect {
+	return Vect{p.X.Mul(q.X) - p.Y.Mul(q.Y), p.X.Mul(q.Y) + p.Y.Mul(q.X)}
+}
+
+// Inverse of Rotate().
+func (p Vect) UnRotate(q Vect) Vect {
+	return Vect{p.X.Mul(q.X) + p.Y.Mul(q.Y), p.Y.Mul(q.X) - p.X.Mul(q.Y)}
+}
+
+// Returns the unit length vector for the given angle (in radians).
+func ForAngle(a Q16) Vect {
+	s, c := a.Sincos()
+	return Vect{c, s}
+}
+
+// Returns the angular direction p is pointing in (in radians).
+func (p Vect) ToAngle() Q16 { return p.Y.Atan2(p.X) }
+
+// Returns the squared length of p.
+func (p Vect) LengthSq() Q16 { return p.Dot(p) }
+
+// Returns the length of p, computed in Q32 to avoid overflow.
+func (p Vect) Length() Q16 {
+	x, y := p.X.Q32(), p.Y.Q32()
+	return (x.Mul(x) + y.Mul(y)).Sqrt().Q16()
+}
+
+// Returns a normalized copy of p, or the zero vector.
+func (p Vect) Normalize() Vect {
+	l := p.Length()
+	if l == 0 {
+		return Vect{}
+	}
+	return Vect{p.X.Div(l), p.Y.Div(l)}
+}
+
+// Linearly interpolate between p and q.
+func (p Vect) Lerp(q Vect, t Q16) Vect {
+	return Vect{p.X.Lerp(q.X, t), p.Y.Lerp(q.Y, t)}
+}
+
+// Clamp p to length l.
+func (p Vect) Clamp(l Q16) Vect {
+	if p.Length() > l {
+		return p.Normalize().Mult(l)
+	}
+	return p
+}
+
+// Returns the distance between p and q.
+func (p Vect) Dist(q Vect) Q16 { return p.Sub(q).Length() }
+
+// Returns the squared distance between p and q.
+func (p Vect) DistSq(q Vect) Q16 { return p.Sub(q).LengthSq() }
+
+// Returns true if the distance between p and q is less than dist.
+func (p Vect) Near(q Vect, dist Q16) bool { return p.Dist(q) < dist }
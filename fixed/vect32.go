@@ -0,0 +1,113 @@
+package fixed
+
+import "math/bits"
+import "github.com/oniproject/math/f"
+import "github.com/oniproject/math/v"
+
+// Q32 counterpart of Vect.
+type Vect32 struct{ X, Y Q32 }
+
+// Convenience constructor for Vect32 structs.
+func V32(x, y Q32) Vect32 { return Vect32{x, y} }
+
+// Convert a v.Vect to the nearest Q32 vector.
+func Vect32From(p v.Vect) Vect32 {
+	return Vect32{Q32FromFloat(float64(p.X)), Q32FromFloat(float64(p.Y))}
+}
+
+// Returns p as a v.Vect.
+func (p Vect32) Vect() v.Vect {
+	return v.V(f.Float(p.X.Float()), f.Float(p.Y.Float()))
+}
+
+// Returns p converted to Vect32. The conversion is exact.
+func (p Vect) Q32() Vect32 { return Vect32{p.X.Q32(), p.Y.Q32()} }
+
+// Returns p converted to Vect, saturated to the Q16 range.
+func (p Vect32) Q16() Vect { return Vect{p.X.Q16(), p.Y.Q16()} }
+
+// Add two vectors.
+func (p Vect32) Add(q Vect32) Vect32 { return Vect32{p.X + q.X, p.Y + q.Y} }
+
+// Subtract two vectors.
+func (p Vect32) Sub(q Vect32) Vect32 { return Vect32{p.X - q.X, p.Y - q.Y} }
+
+// Negate a vector.
+func (p Vect32) Neg() Vect32 { return Vect32{-p.X, -p.Y} }
+
+// Scalar multiplication.
+func (p Vect32) Mult(s Q32) Vect32 { return Vect32{p.X.Mul(s), p.Y.Mul(s)} }
+
+// Vector dot product.
+func (p Vect32) Dot(q Vect32) Q32 { return p.X.Mul(q.X) + p.Y.Mul(q.Y) }
+
+// 2D vector cross product analog.
+func (p Vect32) Cross(q Vect32) Q32 { return p.X.Mul(q.Y) - p.Y.Mul(q.X) }
+
+// Returns a perpendicular vector. (90 degree rotation)
+func (p Vect32) LPerp() Vect32 { return Vect32{-p.Y, p.X} }
+
+// Returns a perpendicular vector. (-90 degree rotation)
+func (p Vect32) RPerp() Vect32 { return Vect32{p.Y, -p.X} }
+
+// Uses complex number multiplication to rotate p by q.
+func (p Vect32) Rotate(q Vect32) Vect32 {
+	return Vect32{p.X.Mul(q.X) - p.Y.Mul(q.Y), p.X.Mul(q.Y) + p.Y.Mul(q.X)}
+}
+
+// Inverse of Rotate().
+func (p Vect32) UnRotate(q Vect32) Vect32 {
+	return Vect32{p.X.Mul(q.X) + p.Y.Mul(q.Y), p.Y.Mul(q.X) - p.X.Mul(q.Y)}
+}
+
+// Returns the unit length vector for the given angle (in radians).
+func ForAngle32(a Q32) Vect32 {
+	s, c := a.Sincos()
+	return Vect32{c, s}
+}
+
+// Returns the angular direction p is pointing in (in radians).
+func (p Vect32) ToAngle() Q32 { return p.Y.Atan2(p.X) }
+
+// Returns the squared length of p.
+func (p Vect32) LengthSq() Q32 { return p.Dot(p) }
+
+// Returns the length of p, computed in 128 bits to avoid overflow.
+func (p Vect32) Length() Q32 {
+	x, y := abs64(int64(p.X)), abs64(int64(p.Y))
+	xh, xl := bits.Mul64(x, x)
+	yh, yl := bits.Mul64(y, y)
+	lo, carry := bits.Add64(xl, yl, 0)
+	return signed64(isqrt128(xh+yh+carry, lo), false)
+}
+
+// Returns a normalized copy of p, or the zero vector.
+func (p Vect32) Normalize() Vect32 {
+	l := p.Length()
+	if l == 0 {
+		return Vect32{}
+	}
+	return Vect32{p.X.Div(l), p.Y.Div(l)}
+}
+
+// Linearly interpolate between p and q.
+func (p Vect32) Lerp(q Vect32, t Q32) Vect32 {
+	return Vect32{p.X.Lerp(q.X, t), p.Y.Lerp(q.Y, t)}
+}
+
+// Clamp p to length l.
+func (p Vect32) Clamp(l Q32) Vect32 {
+	if p.Length() > l {
+		return p.Normalize().Mult(l)
+	}
+	return p
+}
+
+// Returns the distance between p and q.
+func (p Vect32) Dist(q Vect32) Q32 { return p.Sub(q).Length() }
+
+// Returns the squared distance between p and q.
+func (p Vect32) DistSq(q Vect32) Q32 { return p.Sub(q).LengthSq() }
+
+// Returns true if the distance between p and q is less than dist.
+func (p Vect32) Near(q Vect32, dist Q32) bool { return p.Dist(q) < dist }
@@ -0,0 +1,154 @@
+// Generic counterpart of package aabb for any floating point type.
+package aabb
+
+import "github.com/oniproject/math/generic/f"
+import "github.com/oniproject/math/generic/v"
+
+// Chipmunk's axis-aligned 2D bounding box type. (left, bottom, right, top)
+type AABB[T f.Float] struct {
+	L, B, R, T T
+}
+
+// Convenience constructor for AABB structs.
+func New[T f.Float](l, b, r, t T) AABB[T] {
+	return AABB[T]{l, b, r, t}
+}
+
+// Constructs a AABB centered on a point with the given extents (half sizes).
+func ForExtents[T f.Float](c v.Vect[T], hw, hh T) AABB[T] {
+	return AABB[T]{c.X - hw, c.Y - hh, c.X + hw, c.Y + hh}
+}
+
+// Constructs a AABB for a circle with the given position and radius.
+func ForCircle[T f.Float](c v.Vect[T], r T) AABB[T] {
+	return AABB[T]{c.X - r, c.Y - r, c.X + r, c.Y + r}
+}
+
+// Check if all edges of two bounding boxes are equal within the tolerances of f.ApproxEqual.
+func ApproxEqual[T f.Float](a, b AABB[T], absEps, relEps T) bool {
+	return f.ApproxEqual(a.L, b.L, absEps, relEps) && f.ApproxEqual(a.B, b.B, absEps, relEps) &&
+		f.ApproxEqual(a.R, b.R, absEps, relEps) && f.ApproxEqual(a.T, b.T, absEps, relEps)
+}
+
+// Check if all edges of two bounding boxes are at most n ULPs apart.
+func WithinULPs[T f.Float](a, b AABB[T], n uint64) bool {
+	return f.WithinULPs(a.L, b.L, n) && f.WithinULPs(a.B, b.B, n) &&
+		f.WithinULPs(a.R, b.R, n) && f.WithinULPs(a.T, b.T, n)
+}
+
+// Returns true if @c a and @c b intersect.
+func Intersects[T f.Float](a, b AABB[T]) bool {
+	return a.L <= b.R && b.L <= a.R && a.B <= b.T && b.B <= a.T
+}
+
+// Returns true if @c other lies completely within @c bb.
+func (bb AABB[T]) Contains(other AABB[T]) bool {
+	return bb.L <= other.L && bb.R >= other.R && bb.B <= other.B && bb.T >= other.T
+}
+
+// Returns true if @c bb contains @c v.
+func (bb AABB[T]) ContainsVect(v v.Vect[T]) bool {
+	return (bb.L <= v.X && bb.R >= v.X && bb.B <= v.Y && bb.T >= v.Y)
+}
+
+// Returns a bounding box that holds both bounding boxes.
+func Merge[T f.Float](a, b AABB[T]) AABB[T] {
+	return AABB[T]{
+		f.Min(a.L, b.L), f.Min(a.B, b.B),
+		f.Max(a.R, b.R), f.Max(a.T, b.T),
+	}
+}
+
+// Returns a bounding box that holds both @c bb and @c v.
+func Expand[T f.Float](bb AABB[T], v v.Vect[T]) AABB[T] {
+	return AABB[T]{
+		f.Min(bb.L, v.X), f.Min(bb.B, v.Y),
+		f.Max(bb.R, v.X), f.Max(bb.T, v.Y),
+	}
+}
+
+// Returns the center of a bounding box.
+func (bb AABB[T]) Center() v.Vect[T] {
+	return v.Lerp(v.V(bb.L, bb.B), v.V(bb.R, bb.T), 0.5)
+}
+
+// Returns the area of the bounding box.
+func (bb AABB[T]) Area() T {
+	return (bb.R - bb.L) * (bb.T - bb.B)
+}
+
+// Merges @c a and @c b and returns the area of the merged bounding box.
+func MergedArea[T f.Float](a, b AABB[T]) T {
+	rl := f.Max(a.R, b.R) - f.Min(a.L, b.L)
+	tb := f.Max(a.T, b.T) - f.Min(a.B, b.B)
+	return rl * tb
+}
+
+// Returns the fraction along the segment query the AABB is hit. Returns INFINITY if it doesn't hit.
+func (bb *AABB[T]) SegmentQuery(a, b v.Vect[T]) T {
+	delta := v.Sub(b, a)
+	inf := f.Inf[T]()
+	tmin, tmax := -inf, inf
+
+	if delta.X != 0.0 {
+		t1 := (bb.L - a.X) / delta.X
+		t2 := (bb.R - a.X) / delta.X
+		tmin = f.Max(tmin, f.Min(t1, t2))
+		tmax = f.Min(tmax, f.Max(t1, t2))
+	}
+
+	if delta.Y != 0.0 {
+		t1 := (bb.B - a.Y) / delta.Y
+		t2 := (bb.T - a.Y) / delta.Y
+		tmin = f.Max(tmin, f.Min(t1, t2))
+		tmax = f.Min(tmax, f.Max(t1, t2))
+	}
+
+	if tmin <= tmax && 0.0 <= tmax && tmin <= 1.0 {
+		return f.Max(tmin, 0.0)
+	}
+
+	return inf
+}
+
+// Return true if the bounding box intersects the line segment with ends @c a and @c b.
+func (bb *AABB[T]) IntersectsSegment(a, b v.Vect[T]) bool {
+	return bb.SegmentQuery(a, b) != f.Inf[T]()
+}
+
+// Clamp a vector to a bounding box.
+func (bb *AABB[T]) ClampVect(p v.Vect[T]) v.Vect[T] {
+	return v.V(f.Clamp(p.X, bb.L, bb.R), f.Clamp(p.Y, bb.B, bb.T))
+}
+
+// Wrap a vector to a bounding box.
+func (bb *AABB[T]) WrapVect(p v.Vect[T]) v.Vect[T] {
+	dx := f.Abs(bb.R - bb.L)
+	x := f.Mod(p.X-bb.L, dx)
+	if x <= 0.0 {
+		x += dx
+	}
+
+	dy := f.Abs(bb.T - bb.B)
+	y := f.Mod(p.Y-bb.B, dy)
+	if y <= 0.0 {
+		y += dy
+	}
+
+	return v.V(x+bb.L, y+bb.B)
+}
+
+// Returns a bounding box offseted by @c v.
+func Offset[T f.Float](bb AABB[T], p v.Vect[T]) AABB[T] {
+	return New(
+		bb.L+p.X,
+		bb.B+p.Y,
+		bb.R+p.X,
+		bb.T+p.Y,
+	)
+}
+
+// Convert a bounding box to another component type.
+func Convert[U, T f.Float](bb AABB[T]) AABB[U] {
+	return AABB[U]{U(bb.L), U(bb.B), U(bb.R), U(bb.T)}
+}
@@ -0,0 +1,15 @@
+package aabb
+
+import "github.com/oniproject/math/generic/f"
+
+type Bounds[T f.Float] struct {
+	Min, Max T
+}
+
+func BoundsOverlap[T f.Float](a, b Bounds[T]) bool {
+	return (a.Min <= b.Max && b.Min <= a.Max)
+}
+
+func ToBounds[T f.Float](bb AABB[T]) Bounds[T] {
+	return Bounds[T]{bb.L, bb.R}
+}
@@ -0,0 +1,46 @@
+package f
+
+import "math"
+import "unsafe"
+
+// Returns true if a and b differ by at most absEps, or by at most
+// relEps times the larger magnitude of the two.
+func ApproxEqual[T Float](a, b, absEps, relEps T) bool {
+	if a == b {
+		return true
+	}
+	d := Abs(a - b)
+	return d <= absEps || d <= relEps*Max(Abs(a), Abs(b))
+}
+
+// Returns the number of representable values of T between a and b.
+// Zero for +0 and -0, the maximum uint64 if either is NaN.
+func ULPDistance[T Float](a, b T) uint64 {
+	if a != a || b != b {
+		return math.MaxUint64
+	}
+	key := func(x T) int64 {
+		if unsafe.Sizeof(x) == 4 {
+			bits := math.Float32bits(float32(x))
+			if bits>>31 != 0 {
+				return -int64(bits &^ (1 << 31))
+			}
+			return int64(bits)
+		}
+		bits := math.Float64bits(float64(x))
+		if bits>>63 != 0 {
+			return -int64(bits &^ (1 << 63))
+		}
+		return int64(bits)
+	}
+	ka, kb := key(a), key(b)
+	if ka < kb {
+		ka, kb = kb, ka
+	}
+	return uint64(ka) - uint64(kb)
+}
+
+// Returns true if a and b are at most n representable values of T apart.
+func WithinULPs[T Float](a, b T, n uint64) bool {
+	return ULPDistance(a, b) <= n
+}
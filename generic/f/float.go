@@ -0,0 +1,68 @@
+// Generic counterpart of package f for any floating point type.
+package f
+
+import "math"
+import "unsafe"
+
+// Floating point types usable as vector components.
+type Float interface {
+	~float32 | ~float64
+}
+
+const Pi = math.Pi
+
+// Returns positive infinity.
+func Inf[T Float]() T { return T(math.Inf(+1)) }
+
+// Returns the smallest positive normal value of T.
+func FloatMin[T Float]() T {
+	var x T
+	if unsafe.Sizeof(x) == 4 {
+		min := 0x1p-126
+		return T(min)
+	}
+	min := 0x1p-1022
+	return T(min)
+}
+
+func Sqrt[T Float](x T) T     { return T(math.Sqrt(float64(x))) }
+func Sin[T Float](x T) T      { return T(math.Sin(float64(x))) }
+func Cos[T Float](x T) T      { return T(math.Cos(float64(x))) }
+func Acos[T Float](x T) T     { return T(math.Acos(float64(x))) }
+func Atan2[T Float](x, y T) T { return T(math.Atan2(float64(x), float64(y))) }
+func Mod[T Float](x, y T) T   { return T(math.Mod(float64(x), float64(y))) }
+func Exp[T Float](x T) T      { return T(math.Exp(float64(x))) }
+
+// Return the max of two Floats.
+func Max[T Float](a, b T) T {
+	if a > b {
+		return a
+	}
+	return b
+}
+
+// Return the min of two Floats.
+func Min[T Float](a, b T) T {
+	if a < b {
+		return a
+	}
+	return b
+}
+
+// Return the absolute value of a Float.
+func Abs[T Float](f T) T {
+	if f < 0 {
+		return -f
+	}
+	return f
+}
+
+// Clamp f to be between min and max.
+func Clamp[T Float](f, min, max T) T {
+	return Min(Max(f, min), max)
+}
+
+// Linearly interpolate (or extrapolate) between f1 and f2 by t percent.
+func Lerp[T Float](f1, f2, t T) T {
+	return f1*(1.0-t) + f2*t
+}
@@ -0,0 +1,180 @@
+// Generic counterpart of package t for any floating point type.
+package t
+
+import "github.com/oniproject/math/generic/f"
+import "github.com/oniproject/math/generic/v"
+import "github.com/oniproject/math/generic/aabb"
+
+type Transform[T f.Float] struct {
+	A, B, C, D, Tx, Ty T
+}
+
+// Transform an absolute point. (i.e. a vertex)
+// Products are converted with T() so the compiler cannot fuse them into FMA,
+// the results are the same on every GOAMD64 level and match package batch.
+func (t *Transform[T]) Point(p v.Vect[T]) v.Vect[T] {
+	return v.Vect[T]{
+		X: T(t.A*p.X) + T(t.C*p.Y) + t.Tx,
+		Y: T(t.B*p.X) + T(t.D*p.Y) + t.Ty,
+	}
+}
+func (t *Transform[T]) PointInverse(p v.Vect[T]) v.Vect[T] {
+	id := 1.0 / (t.A*t.D - t.C*t.B)
+	x, y := p.X, p.Y
+	return v.Vect[T]{
+		X: t.D*id*x + -t.C*id*y + (+t.Ty*t.C-t.Tx*t.D)*id,
+		Y: t.A*id*y + -t.B*id*x + (-t.Ty*t.A+t.Tx*t.B)*id,
+	}
+}
+
+// Transform a vector (i.e. a normal)
+func (t *Transform[T]) Vect(p v.Vect[T]) v.Vect[T] {
+	return v.Vect[T]{
+		X: T(t.A*p.X) + T(t.C*p.Y),
+		Y: T(t.B*p.X) + T(t.D*p.Y),
+	}
+}
+
+// Identity transform matrix.
+func Identity[T f.Float]() Transform[T] {
+	return Transform[T]{1.0, 0.0, 0.0, 1.0, 0.0, 0.0}
+}
+
+// Construct a new transform matrix.
+// (a, b) is the x basis vector.
+// (c, d) is the y basis vector.
+// (tx, ty) is the translation.
+func New[T f.Float](a, b, c, d, tx, ty T) Transform[T] {
+	return Transform[T]{a, b, c, d, tx, ty}
+}
+
+// Construct a new transform matrix in transposed order.
+func Transpose[T f.Float](a, c, tx, b, d, ty T) Transform[T] {
+	return Transform[T]{a, b, c, d, tx, ty}
+}
+
+// Check if all entries of two transform matrices are equal within the tolerances of f.ApproxEqual.
+func ApproxEqual[T f.Float](t1, t2 Transform[T], absEps, relEps T) bool {
+	return f.ApproxEqual(t1.A, t2.A, absEps, relEps) && f.ApproxEqual(t1.B, t2.B, absEps, relEps) &&
+		f.ApproxEqual(t1.C, t2.C, absEps, relEps) && f.ApproxEqual(t1.D, t2.D, absEps, relEps) &&
+		f.ApproxEqual(t1.Tx, t2.Tx, absEps, relEps) && f.ApproxEqual(t1.Ty, t2.Ty, absEps, relEps)
+}
+
+// Check if all entries of two transform matrices are at most n ULPs apart.
+func WithinULPs[T f.Float](t1, t2 Transform[T], n uint64) bool {
+	return f.WithinULPs(t1.A, t2.A, n) && f.WithinULPs(t1.B, t2.B, n) &&
+		f.WithinULPs(t1.C, t2.C, n) && f.WithinULPs(t1.D, t2.D, n) &&
+		f.WithinULPs(t1.Tx, t2.Tx, n) && f.WithinULPs(t1.Ty, t2.Ty, n)
+}
+
+// Get the inverse of a transform matrix.
+func Inverse[T f.Float](t Transform[T]) Transform[T] {
+	inv_det := 1.0 / (t.A*t.D - t.C*t.B)
+	return Transpose(
+		+t.D*inv_det, -t.C*inv_det, (t.C*t.Ty-t.Tx*t.D)*inv_det,
+		-t.B*inv_det, +t.A*inv_det, (t.Tx*t.B-t.A*t.Ty)*inv_det,
+	)
+}
+
+// Multiply two transformation matrices.
+func Mult[T f.Float](t1, t2 Transform[T]) Transform[T] {
+	return Transpose(
+		t1.A*t2.A+t1.C*t2.B, t1.A*t2.C+t1.C*t2.D, t1.A*t2.Tx+t1.C*t2.Ty+t1.Tx,
+		t1.B*t2.A+t1.D*t2.B, t1.B*t2.C+t1.D*t2.D, t1.B*t2.Tx+t1.D*t2.Ty+t1.Ty,
+	)
+}
+
+// Transform a cpBB.
+func (t *Transform[T]) BB(bb aabb.AABB[T]) aabb.AABB[T] {
+	center := bb.Center()
+	hw := (bb.R - bb.L) * 0.5
+	hh := (bb.T - bb.B) * 0.5
+
+	a, b, d, e := t.A*hw, t.C*hh, t.B*hw, t.D*hh
+	hw_max := f.Max(f.Abs(a+b), f.Abs(a-b))
+	hh_max := f.Max(f.Abs(d+e), f.Abs(d-e))
+	return aabb.ForExtents(t.Point(center), hw_max, hh_max)
+}
+
+// Create a transation matrix.
+func Translate[T f.Float](translate v.Vect[T]) Transform[T] {
+	return Transpose(
+		1.0, 0.0, translate.X,
+		0.0, 1.0, translate.Y,
+	)
+}
+
+// Create a scale matrix.
+func Scale[T f.Float](scaleX, scaleY T) Transform[T] {
+	return Transpose(
+		scaleX, 0.0, 0.0,
+		0.0, scaleY, 0.0,
+	)
+}
+
+// Create a rotation matrix.
+func Rotate[T f.Float](radians T) Transform[T] {
+	rot := v.ForAngle(radians)
+	return Transpose(
+		rot.X, -rot.Y, 0.0,
+		rot.Y, +rot.X, 0.0,
+	)
+}
+
+// Create a rigid transformation matrix. (transation + rotation)
+func Rigid[T f.Float](translate v.Vect[T], radians T) Transform[T] {
+	rot := v.ForAngle(radians)
+	return Transpose(
+		rot.X, -rot.Y, translate.X,
+		rot.Y, +rot.X, translate.Y,
+	)
+}
+
+// Fast inverse of a rigid transformation matrix.
+func RigidInverse[T f.Float](t Transform[T]) Transform[T] {
+	return Transpose(
+		+t.D, -t.C, (t.C*t.Ty - t.Tx*t.D),
+		-t.B, +t.A, (t.Tx*t.B - t.A*t.Ty),
+	)
+}
+
+// XXX: Miscellaneous (but useful) transformation matrices.
+// See source for documentation...
+
+func Wrap[T f.Float](outer, inner Transform[T]) Transform[T] {
+	return Mult(Inverse(outer), Mult(inner, outer))
+}
+
+func WrapInverse[T f.Float](outer, inner Transform[T]) Transform[T] {
+	return Mult(outer, Mult(inner, Inverse(outer)))
+}
+
+func Ortho[T f.Float](bb aabb.AABB[T]) Transform[T] {
+	return Transpose(
+		2.0/(bb.R-bb.L), 0.0, -(bb.R+bb.L)/(bb.R-bb.L),
+		0.0, 2.0/(bb.T-bb.B), -(bb.T+bb.B)/(bb.T-bb.B),
+	)
+}
+
+func BoneScale[T f.Float](v0, v1 v.Vect[T]) Transform[T] {
+	d := v.Sub(v1, v0)
+	return Transpose(
+		d.X, -d.Y, v0.X,
+		d.Y, +d.X, v0.Y,
+	)
+}
+
+func AxialScale[T f.Float](axis, pivot v.Vect[T], scale T) Transform[T] {
+	A := axis.X * axis.Y * (scale - 1.0)
+	B := v.Dot(axis, pivot) * (1.0 - scale)
+
+	return Transpose(
+		scale*axis.X*axis.X+axis.Y*axis.Y, A, axis.X*B,
+		A, axis.X*axis.X+scale*axis.Y*axis.Y, axis.Y*B,
+	)
+}
+
+// Convert a transform matrix to another component type.
+func Convert[U, T f.Float](t Transform[T]) Transform[U] {
+	return Transform[U]{U(t.A), U(t.B), U(t.C), U(t.D), U(t.Tx), U(t.Ty)}
+}
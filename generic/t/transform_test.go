@@ -0,0 +1,37 @@
+package t
+
+import (
+	"github.com/oniproject/math/generic/aabb"
+	"github.com/oniproject/math/generic/v"
+	. "github.com/smartystreets/goconvey/convey"
+	"testing"
+)
+
+func TestTransform(test *testing.T) {
+	Convey("Generic transform", test, func() {
+		m := Mult(Translate(v.V(2.0, 3.0)), Rotate(0.5))
+		p := v.V(-1.0, 8.0)
+
+		Convey("Inverse", func() {
+			q := Inverse(m)
+			r := q.Point(m.Point(p))
+			So(r.X, ShouldAlmostEqual, p.X, 1e-12)
+			So(r.Y, ShouldAlmostEqual, p.Y, 1e-12)
+			So(m.PointInverse(m.Point(p)).Y, ShouldAlmostEqual, p.Y, 1e-12)
+		})
+
+		Convey("Convert", func() {
+			m32 := Convert[float32](m)
+			p32 := m32.Point(v.Convert[float32](p))
+			p64 := m.Point(p)
+			So(p32.X, ShouldAlmostEqual, p64.X, 1e-5)
+			So(p32.Y, ShouldAlmostEqual, p64.Y, 1e-5)
+		})
+
+		Convey("BB", func() {
+			s := Scale[float64](2, 3)
+			So(s.BB(aabb.New(-1.0, -1, 1, 1)), ShouldResemble, aabb.New(-2.0, -3, 2, 3))
+			So(aabb.Convert[float32](aabb.New(-1.0, -1, 1, 1)), ShouldResemble, aabb.New[float32](-1, -1, 1, 1))
+		})
+	})
+}
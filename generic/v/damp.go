@@ -0,0 +1,33 @@
+package v
+
+import "github.com/oniproject/math/generic/f"
+
+// Gradually move current towards target like a critically damped spring.
+// velocity holds the current velocity and is updated by every call.
+// smoothTime is roughly the time to reach the target, the speed is limited to maxSpeed.
+func SmoothDamp[T f.Float](current, target Vect[T], velocity *Vect[T], smoothTime, maxSpeed, dt T) Vect[T] {
+	smoothTime = f.Max(0.0001, smoothTime)
+	omega := 2.0 / smoothTime
+	x := omega * dt
+	exp := 1.0 / (1.0 + x + 0.48*x*x + 0.235*x*x*x)
+
+	change := Clamp(Sub(current, target), maxSpeed*smoothTime)
+	to := Sub(current, change)
+
+	temp := Mult(Add(*velocity, Mult(change, omega)), dt)
+	*velocity = Mult(Sub(*velocity, Mult(temp, omega)), exp)
+	output := Add(to, Mult(Add(change, temp), exp))
+
+	// Prevent overshooting.
+	if Dot(Sub(target, current), Sub(output, target)) > 0.0 {
+		output = target
+		*velocity = Zero[T]()
+	}
+	return output
+}
+
+// Frame rate independent exponential decay of a towards b.
+// lambda is the decay rate, a larger lambda approaches b faster.
+func Damp[T f.Float](a, b Vect[T], lambda, dt T) Vect[T] {
+	return Lerp(a, b, 1.0-f.Exp(-lambda*dt))
+}
@@ -0,0 +1,199 @@
+// Generic counterpart of package v for any floating point type.
+package v
+
+import "github.com/oniproject/math/generic/f"
+
+// Chipmunk's 2D vector type along with a handy 2D vector math lib.
+type Vect[T f.Float] struct{ X, Y T }
+
+// Constant for the zero vector.
+func Zero[T f.Float]() Vect[T] { return Vect[T]{} }
+
+// Convenience constructor for Vect structs.
+func V[T f.Float](x, y T) Vect[T] { return Vect[T]{x, y} }
+
+// Convert a vector to another component type.
+func Convert[U, T f.Float](v Vect[T]) Vect[U] { return Vect[U]{U(v.X), U(v.Y)} }
+
+// Check if two vectors are equal.
+// (Be careful when comparing floating point numbers, see ApproxEqual!)
+func Eql[T f.Float](v1, v2 Vect[T]) bool { return v1.X == v2.X && v1.Y == v2.Y }
+
+// Check if both components of two vectors are equal within the tolerances of f.ApproxEqual.
+func ApproxEqual[T f.Float](v1, v2 Vect[T], absEps, relEps T) bool {
+	return f.ApproxEqual(v1.X, v2.X, absEps, relEps) && f.ApproxEqual(v1.Y, v2.Y, absEps, relEps)
+}
+
+// Check if both components of two vectors are at most n ULPs apart.
+func WithinULPs[T f.Float](v1, v2 Vect[T], n uint64) bool {
+	return f.WithinULPs(v1.X, v2.X, n) && f.WithinULPs(v1.Y, v2.Y, n)
+}
+
+// Add two vectors
+func Add[T f.Float](v1, v2 Vect[T]) Vect[T] { return Vect[T]{v1.X + v2.X, v1.Y + v2.Y} }
+
+// Subtract two vectors.
+func Sub[T f.Float](v1, v2 Vect[T]) Vect[T] { return Vect[T]{v1.X - v2.X, v1.Y - v2.Y} }
+
+// Negate a vector.
+func Neg[T f.Float](v Vect[T]) Vect[T] { return Vect[T]{-v.X, -v.Y} }
+
+// Scalar multiplication.
+func Mult[T f.Float](v Vect[T], s T) Vect[T] { return Vect[T]{v.X * s, v.Y * s} }
+
+// Vector dot product.
+func Dot[T f.Float](v1, v2 Vect[T]) T { return T(v1.X*v2.X) + T(v1.Y*v2.Y) }
+
+// 2D vector cross product analog.
+// The cross product of 2D vectors results in a 3D vector with only a z component.
+// This function returns the magnitude of the z value.
+func Cross[T f.Float](v1, v2 Vect[T]) T { return v1.X*v2.Y - v1.Y*v2.X }
+
+// Returns a perpendicular vector. (90 degree rotation)
+func LPerp[T f.Float](v Vect[T]) Vect[T] { return Vect[T]{-v.Y, v.X} }
+
+// Returns a perpendicular vector. (-90 degree rotation)
+func RPerp[T f.Float](v Vect[T]) Vect[T] { return Vect[T]{v.Y, -v.X} }
+
+// Returns the vector projection of v1 onto v2.
+func Project[T f.Float](v1, v2 Vect[T]) Vect[T] {
+	return Mult(v2, Dot(v1, v2)/Dot(v2, v2))
+}
+
+// Returns the unit length vector for the given angle (in radians).
+func ForAngle[T f.Float](a T) Vect[T] { return Vect[T]{f.Cos(a), f.Sin(a)} }
+
+// Returns the angular direction v is pointing in (in radians).
+func ToAngle[T f.Float](v Vect[T]) T { return f.Atan2(v.Y, v.X) }
+
+// Uses complex number multiplication to rotate v1 by v2.
+// Scaling will occur if v1 is not a unit vector.
+func Rotate[T f.Float](v1, v2 Vect[T]) Vect[T] {
+	return Vect[T]{v1.X*v2.X - v1.Y*v2.Y, v1.X*v2.Y + v1.Y*v2.X}
+}
+
+// Inverse of Rotate().
+func UnRotate[T f.Float](v1, v2 Vect[T]) Vect[T] {
+	return Vect[T]{v1.X*v2.X + v1.Y*v2.Y, v1.Y*v2.X - v1.X*v2.Y}
+}
+
+// Returns the squared length of v.
+// Faster than Length() when you only need to compare lengths.
+func LengthSq[T f.Float](v Vect[T]) T { return Dot(v, v) }
+
+// Returns the length of v.
+func Length[T f.Float](v Vect[T]) T { return f.Sqrt(Dot(v, v)) }
+
+// Linearly interpolate between v1 and v2.
+func Lerp[T f.Float](v1, v2 Vect[T], t T) Vect[T] {
+	return Add(Mult(v1, 1.0-t), Mult(v2, t))
+}
+
+// Returns a normalized copy of v.
+func Normalize[T f.Float](v Vect[T]) Vect[T] {
+	// Neat trick I saw somewhere to avoid div/0.
+	return Mult(v, 1.0/(Length(v)+f.FloatMin[T]()))
+}
+
+// Spherical linearly interpolate between v1 and v2.
+func Slerp[T f.Float](v1, v2 Vect[T], t T) Vect[T] {
+	dot := Dot(Normalize(v1), Normalize(v2))
+	omega := f.Acos(f.Clamp(dot, -1.0, 1.0))
+
+	if omega < 1e-3 {
+		// If the angle between two vectors is very small, lerp instead to avoid precision issues.
+		return Lerp(v1, v2, t)
+	} else {
+		denom := 1.0 / f.Sin(omega)
+		return Add(
+			Mult(v1, f.Sin((1.0-t)*omega)*denom),
+			Mult(v2, f.Sin(t*omega)*denom),
+		)
+	}
+}
+
+// Spherical linearly interpolate between v1 towards v2 by no more than angle a radians
+func SlerpConst[T f.Float](v1, v2 Vect[T], a T) Vect[T] {
+	dot := Dot(Normalize(v1), Normalize(v2))
+	omega := f.Acos(f.Clamp(dot, -1.0, 1.0))
+
+	return Slerp(v1, v2, f.Min(a, omega)/omega)
+}
+
+// Clamp v to length l.
+func Clamp[T f.Float](v Vect[T], l T) Vect[T] {
+	if Dot(v, v) > l*l {
+		return Mult(Normalize(v), l)
+	}
+	return v
+}
+
+// Linearly interpolate between v1 towards v2 by distance d.
+func LerpConst[T f.Float](v1, v2 Vect[T], d T) Vect[T] {
+	return Add(v1, Clamp(Sub(v2, v1), d))
+}
+
+// Returns the distance between v1 and v2.
+func Dist[T f.Float](v1, v2 Vect[T]) T { return Length(Sub(v1, v2)) }
+
+// Returns the squared distance between v1 and v2. Faster than Dist() when you only need to compare distances.
+func DistSq[T f.Float](v1, v2 Vect[T]) T { return LengthSq(Sub(v1, v2)) }
+
+// Returns true if the distance between v1 and v2 is less than dist.
+func Near[T f.Float](v1, v2 Vect[T], dist T) bool {
+	return DistSq(v1, v2) < dist*dist
+}
+
+// Check if two vectors are equal.
+// (Be careful when comparing floating point numbers, see ApproxEqual!)
+func (p *Vect[T]) Eql(q Vect[T]) bool {
+	return p.X == q.X && p.Y == q.Y
+}
+
+// Add two vectors
+func (p *Vect[T]) Add(q Vect[T]) {
+	p.X += q.X
+	p.Y += q.Y
+}
+
+// Subtract two vectors.
+func (p *Vect[T]) Sub(q Vect[T]) {
+	p.X -= q.X
+	p.Y -= q.Y
+}
+
+// Negate a vector.
+func (p *Vect[T]) Neg() {
+	p.X = -p.X
+	p.Y = -p.Y
+}
+
+// Scalar multiplication.
+func (p *Vect[T]) Mult(s T) {
+	p.X *= s
+	p.Y *= s
+}
+
+// Returns the angular direction v is pointing in (in radians).
+func (p *Vect[T]) ToAngle() T { return f.Atan2(p.Y, p.X) }
+
+// Returns the squared length of v.
+// Faster than Length() when you only need to compare lengths.
+func (p Vect[T]) LengthSq() T { return Dot(p, p) }
+
+// Returns the length of v.
+func (p Vect[T]) Length() T { return f.Sqrt(Dot(p, p)) }
+
+// Returns a normalized copy of v.
+func (p *Vect[T]) Normalize() {
+	// Neat trick I saw somewhere to avoid div/0.
+	p.Mult(1.0 / (p.Length() + f.FloatMin[T]()))
+}
+
+// Clamp v to length l.
+func (p *Vect[T]) Clamp(l T) {
+	if Dot(*p, *p) > l*l {
+		p.Normalize()
+		p.Mult(l)
+	}
+}
@@ -0,0 +1,43 @@
+package v
+
+import (
+	. "github.com/smartystreets/goconvey/convey"
+	"math"
+	"testing"
+)
+
+func Test(test *testing.T) {
+	Convey("Generic vector", test, func() {
+		Convey("float32", func() {
+			p := V[float32](3, 4)
+			So(Length(p), ShouldEqual, 5)
+			So(Add(p, p), ShouldResemble, V[float32](6, 8))
+			So(Normalize(Zero[float32]()), ShouldResemble, Zero[float32]())
+		})
+		Convey("float64", func() {
+			p := V(1e9+1, 0.0)
+			q := V(1e9+2, 0.0)
+			So(Dist(p, q), ShouldEqual, 1)
+			So(Dist(Convert[float32](p), Convert[float32](q)), ShouldEqual, 0)
+			So(Normalize(Zero[float64]()), ShouldResemble, Zero[float64]())
+			So(ToAngle(ForAngle(1.0)), ShouldAlmostEqual, 1.0, 1e-15)
+		})
+		Convey("Methods", func() {
+			p := V(5.0, 0)
+			p.Clamp(2)
+			So(p, ShouldResemble, V(2.0, 0))
+			So(p.Length(), ShouldEqual, 2)
+			p.Add(V(1.0, math.Sqrt2))
+			So(p.LengthSq(), ShouldAlmostEqual, 11, 1e-12)
+			So(p.Eql(p), ShouldBeTrue)
+			So(p.Eql(V(0.0, 0)), ShouldBeFalse)
+		})
+		Convey("Approximate equality", func() {
+			p := V[float32](1, 2)
+			So(ApproxEqual(p, V[float32](1, 2.00001), 1e-4, 0), ShouldBeTrue)
+			So(ApproxEqual(p, V[float32](1.1, 2), 1e-4, 0), ShouldBeFalse)
+			So(WithinULPs(p, V(1, math.Nextafter32(2, 3)), 1), ShouldBeTrue)
+			So(WithinULPs(V(1.0, 2), V(1, math.Nextafter(2, 3)), 0), ShouldBeFalse)
+		})
+	})
+}
@@ -0,0 +1,184 @@
+package geom
+
+import "github.com/oniproject/math/f"
+import "github.com/oniproject/math/v"
+import "github.com/oniproject/math/aabb"
+
+// Closest point queries. Point queries return the point q of the shape
+// closest to p, the other point of the pair being p itself.
+// Every query also returns the squared distance between the two points.
+
+// Returns the point of the segment from a to b closest to p.
+func ClosestPointSegment(p, a, b v.Vect) (q v.Vect, distSq f.Float) {
+	ab := v.Sub(b, a)
+	q = a
+	if l := v.LengthSq(ab); l != 0 {
+		t := f.Clamp01(v.Dot(v.Sub(p, a), ab) / l)
+		q = v.Add(a, v.Mult(ab, t))
+	}
+	return q, v.DistSq(p, q)
+}
+
+// Returns the point of the solid box closest to p, p itself if it is inside.
+// Same as bb.ClampVect(p) with the distance.
+func ClosestPointAABB(p v.Vect, bb aabb.AABB) (q v.Vect, distSq f.Float) {
+	q = v.V(f.Clamp(p.X, bb.L, bb.R), f.Clamp(p.Y, bb.B, bb.T))
+	return q, v.DistSq(p, q)
+}
+
+// Returns the point on the edges of the box closest to p.
+// Unlike ClosestPointAABB a point inside is moved to the nearest edge.
+func ClosestPointAABBBoundary(p v.Vect, bb aabb.AABB) (q v.Vect, distSq f.Float) {
+	if !bb.ContainsVect(p) {
+		return ClosestPointAABB(p, bb)
+	}
+	q = v.V(bb.L, p.Y)
+	d := p.X - bb.L
+	if e := bb.R - p.X; e < d {
+		q, d = v.V(bb.R, p.Y), e
+	}
+	if e := p.Y - bb.B; e < d {
+		q, d = v.V(p.X, bb.B), e
+	}
+	if e := bb.T - p.Y; e < d {
+		q, d = v.V(p.X, bb.T), e
+	}
+	return q, d * d
+}
+
+// Returns the point of the solid triangle abc closest to p, in either winding.
+func ClosestPointTriangle(p, a, b, c v.Vect) (q v.Vect, distSq f.Float) {
+	ab, ac := v.Sub(b, a), v.Sub(c, a)
+	if v.Cross(ab, ac) == 0 {
+		// Degenerate, the closest of the edges.
+		return closestPointEdges(p, []v.Vect{a, b, c}, false)
+	}
+
+	// Voronoi regions of the vertices and edges, from Ericson's
+	// Real-Time Collision Detection 5.1.5.
+	ap := v.Sub(p, a)
+	d1, d2 := v.Dot(ab, ap), v.Dot(ac, ap)
+	if d1 <= 0 && d2 <= 0 {
+		return a, v.DistSq(p, a)
+	}
+	bp := v.Sub(p, b)
+	d3, d4 := v.Dot(ab, bp), v.Dot(ac, bp)
+	if d3 >= 0 && d4 <= d3 {
+		return b, v.DistSq(p, b)
+	}
+	if vc := d1*d4 - d3*d2; vc <= 0 && d1 >= 0 && d3 <= 0 {
+		q = v.Add(a, v.Mult(ab, d1/(d1-d3)))
+		return q, v.DistSq(p, q)
+	}
+	cp := v.Sub(p, c)
+	d5, d6 := v.Dot(ab, cp), v.Dot(ac, cp)
+	if d6 >= 0 && d5 <= d6 {
+		return c, v.DistSq(p, c)
+	}
+	if vb := d5*d2 - d1*d6; vb <= 0 && d2 >= 0 && d6 <= 0 {
+		q = v.Add(a, v.Mult(ac, d2/(d2-d6)))
+		return q, v.DistSq(p, q)
+	}
+	if va := d3*d6 - d5*d4; va <= 0 && d4-d3 >= 0 && d5-d6 >= 0 {
+		q = v.Lerp(b, c, (d4-d3)/((d4-d3)+(d5-d6)))
+		return q, v.DistSq(p, q)
+	}
+	return p, 0
+}
+
+// Returns the point of the solid simple polygon closest to p, in either winding.
+// The polygon must have at least one vertex.
+func ClosestPointPolygon(p v.Vect, verts []v.Vect) (q v.Vect, distSq f.Float) {
+	if len(verts) >= 3 && polygonContains(verts, p) {
+		return p, 0
+	}
+	return closestPointEdges(p, verts, len(verts) < 3)
+}
+
+// Closest point to p on the closed outline through verts, or on the open
+// polyline if open is set.
+func closestPointEdges(p v.Vect, verts []v.Vect, open bool) (q v.Vect, distSq f.Float) {
+	q, distSq = verts[0], v.DistSq(p, verts[0])
+	for i, a := range verts {
+		j := i + 1
+		if j == len(verts) {
+			if open {
+				break
+			}
+			j = 0
+		}
+		if c, d := ClosestPointSegment(p, a, verts[j]); d < distSq {
+			q, distSq = c, d
+		}
+	}
+	return q, distSq
+}
+
+// Crossing number test of p against a simple polygon.
+func polygonContains(verts []v.Vect, p v.Vect) bool {
+	in := false
+	a := verts[len(verts)-1]
+	for _, b := range verts {
+		if (a.Y > p.Y) != (b.Y > p.Y) &&
+			p.X < a.X+(p.Y-a.Y)*(b.X-a.X)/(b.Y-a.Y) {
+			in = !in
+		}
+		a = b
+	}
+	return in
+}
+
+// Returns the closest pair of points p on the segment a1 b1 and q on a2 b2.
+// Crossing segments return their intersection in both.
+func ClosestPointsSegmentSegment(a1, b1, a2, b2 v.Vect) (p, q v.Vect, distSq f.Float) {
+	// Ericson's Real-Time Collision Detection 5.1.9.
+	d1, d2 := v.Sub(b1, a1), v.Sub(b2, a2)
+	r := v.Sub(a1, a2)
+	l1, l2 := v.LengthSq(d1), v.LengthSq(d2)
+	e := v.Dot(d2, r)
+
+	var s, t f.Float
+	switch {
+	case l1 == 0 && l2 == 0:
+	case l1 == 0:
+		t = f.Clamp01(e / l2)
+	case l2 == 0:
+		s = f.Clamp01(-v.Dot(d1, r) / l1)
+	default:
+		c := v.Dot(d1, r)
+		b := v.Dot(d1, d2)
+		// Zero for parallel segments, any s works then.
+		if denom := l1*l2 - b*b; denom != 0 {
+			s = f.Clamp01((b*e - c*l2) / denom)
+		}
+		t = (b*s + e) / l2
+		if t < 0 {
+			t, s = 0, f.Clamp01(-c/l1)
+		} else if t > 1 {
+			t, s = 1, f.Clamp01((b-c)/l1)
+		}
+	}
+	p = v.Add(a1, v.Mult(d1, s))
+	q = v.Add(a2, v.Mult(d2, t))
+	return p, q, v.DistSq(p, q)
+}
+
+// Returns the closest pair of points p on the box a and q on b.
+// The separation distance is the root of distSq, zero for overlapping boxes
+// where both points are the center of the overlap.
+func ClosestPointsAABBAABB(a, b aabb.AABB) (p, q v.Vect, distSq f.Float) {
+	p.X, q.X = closestInterval(a.L, a.R, b.L, b.R)
+	p.Y, q.Y = closestInterval(a.B, a.T, b.B, b.T)
+	return p, q, v.DistSq(p, q)
+}
+
+func closestInterval(l1, r1, l2, r2 f.Float) (x1, x2 f.Float) {
+	switch {
+	case r1 < l2:
+		return r1, l2
+	case r2 < l1:
+		return l1, r2
+	}
+	m := (f.Max(l1, l2) + f.Min(r1, r2)) / 2
+	return m, m
+}
@@ -0,0 +1,138 @@
+package geom
+
+import (
+	"github.com/oniproject/math/aabb"
+	"github.com/oniproject/math/f"
+	"github.com/oniproject/math/mathtest"
+	"github.com/oniproject/math/v"
+	. "github.com/smartystreets/goconvey/convey"
+	"testing"
+)
+
+func shouldBeClosest(q v.Vect, distSq f.Float, want v.Vect, wantSq f.Float) {
+	So(q, mathtest.ShouldApproxEqual, want)
+	So(distSq, mathtest.ShouldApproxEqual, wantSq)
+}
+
+func TestClosest(test *testing.T) {
+	Convey("Closest points", test, func() {
+		Convey("ClosestPointSegment", func() {
+			a, b := v.V(0, 0), v.V(4, 0)
+			q, d := ClosestPointSegment(v.V(1, 2), a, b)
+			shouldBeClosest(q, d, v.V(1, 0), 4)
+			q, d = ClosestPointSegment(v.V(-3, 4), a, b)
+			shouldBeClosest(q, d, a, 25)
+			q, d = ClosestPointSegment(v.V(6, 0), a, b)
+			shouldBeClosest(q, d, b, 4)
+			q, d = ClosestPointSegment(v.V(1, 1), a, a)
+			shouldBeClosest(q, d, a, 2)
+		})
+		Convey("ClosestPointAABB", func() {
+			bb := aabb.New(0, 0, 4, 2)
+			q, d := ClosestPointAABB(v.V(6, 5), bb)
+			shouldBeClosest(q, d, v.V(4, 2), 13)
+			So(q, ShouldResemble, bb.ClampVect(v.V(6, 5)))
+			q, d = ClosestPointAABB(v.V(1, 1), bb)
+			shouldBeClosest(q, d, v.V(1, 1), 0)
+
+			q, d = ClosestPointAABBBoundary(v.V(6, 5), bb)
+			shouldBeClosest(q, d, v.V(4, 2), 13)
+			q, d = ClosestPointAABBBoundary(v.V(3.5, 1), bb)
+			shouldBeClosest(q, d, v.V(4, 1), 0.25)
+			q, d = ClosestPointAABBBoundary(v.V(2, 0.25), bb)
+			shouldBeClosest(q, d, v.V(2, 0), 0.0625)
+		})
+		Convey("ClosestPointTriangle", func() {
+			a, b, c := v.V(0, 0), v.V(4, 0), v.V(0, 4)
+			cases := []struct{ p, q v.Vect }{
+				{v.V(-1, -1), a},
+				{v.V(5, -1), b},
+				{v.V(-1, 5), c},
+				{v.V(2, -3), v.V(2, 0)},
+				{v.V(-3, 2), v.V(0, 2)},
+				{v.V(3, 3), v.V(2, 2)},
+				{v.V(1, 1), v.V(1, 1)},
+			}
+			for _, cs := range cases {
+				q, d := ClosestPointTriangle(cs.p, a, b, c)
+				shouldBeClosest(q, d, cs.q, v.DistSq(cs.p, cs.q))
+				// Winding does not matter.
+				q, d = ClosestPointTriangle(cs.p, a, c, b)
+				shouldBeClosest(q, d, cs.q, v.DistSq(cs.p, cs.q))
+			}
+
+			q, d := ClosestPointTriangle(v.V(2, 1), a, b, v.V(2, 0))
+			shouldBeClosest(q, d, v.V(2, 0), 1)
+		})
+		Convey("ClosestPointPolygon", func() {
+			// Concave L shape.
+			poly := []v.Vect{v.V(0, 0), v.V(4, 0), v.V(4, 1), v.V(1, 1), v.V(1, 4), v.V(0, 4)}
+			q, d := ClosestPointPolygon(v.V(2.5, 3), poly)
+			shouldBeClosest(q, d, v.V(1, 3), 2.25)
+			q, d = ClosestPointPolygon(v.V(0.5, 3), poly)
+			shouldBeClosest(q, d, v.V(0.5, 3), 0)
+			q, d = ClosestPointPolygon(v.V(5, -1), poly)
+			shouldBeClosest(q, d, v.V(4, 0), 2)
+
+			q, d = ClosestPointPolygon(v.V(2, 2), poly[:1])
+			shouldBeClosest(q, d, v.V(0, 0), 8)
+			q, d = ClosestPointPolygon(v.V(2, 2), poly[:2])
+			shouldBeClosest(q, d, v.V(2, 0), 4)
+
+			// Agrees with the triangle query.
+			tri := []v.Vect{v.V(0, 0), v.V(4, 0), v.V(0, 4)}
+			for i := 0; i < 50; i++ {
+				p := v.Mult(v.FromAngle(f.Angle(i)), f.Float(i)/10)
+				q1, d1 := ClosestPointPolygon(p, tri)
+				q2, d2 := ClosestPointTriangle(p, tri[0], tri[1], tri[2])
+				shouldBeClosest(q1, d1, q2, d2)
+			}
+		})
+		Convey("ClosestPointsSegmentSegment", func() {
+			p, q, d := ClosestPointsSegmentSegment(v.V(0, 0), v.V(4, 0), v.V(2, 1), v.V(5, 4))
+			So(p, ShouldResemble, v.V(2, 0))
+			So(q, ShouldResemble, v.V(2, 1))
+			So(d, ShouldAlmostEqual, 1)
+
+			// Crossing.
+			p, q, d = ClosestPointsSegmentSegment(v.V(0, 0), v.V(4, 4), v.V(0, 4), v.V(4, 0))
+			So(p, mathtest.ShouldApproxEqual, v.V(2, 2))
+			So(q, mathtest.ShouldApproxEqual, v.V(2, 2))
+			So(d, ShouldAlmostEqual, 0, 1e-5)
+
+			// Parallel.
+			p, q, d = ClosestPointsSegmentSegment(v.V(0, 0), v.V(4, 0), v.V(5, 2), v.V(9, 2))
+			So(p, ShouldResemble, v.V(4, 0))
+			So(q, ShouldResemble, v.V(5, 2))
+			So(d, ShouldAlmostEqual, 5)
+
+			// Degenerate.
+			p, q, d = ClosestPointsSegmentSegment(v.V(1, 3), v.V(1, 3), v.V(0, 0), v.V(4, 0))
+			So(p, ShouldResemble, v.V(1, 3))
+			So(q, ShouldResemble, v.V(1, 0))
+			So(d, ShouldAlmostEqual, 9)
+			p, q, d = ClosestPointsSegmentSegment(v.V(0, 0), v.V(4, 0), v.V(6, 1), v.V(6, 1))
+			So(p, ShouldResemble, v.V(4, 0))
+			So(d, ShouldAlmostEqual, 5)
+			_, _, d = ClosestPointsSegmentSegment(v.V(0, 0), v.V(0, 0), v.V(3, 4), v.V(3, 4))
+			So(d, ShouldAlmostEqual, 25)
+		})
+		Convey("ClosestPointsAABBAABB", func() {
+			a := aabb.New(0, 0, 2, 2)
+			p, q, d := ClosestPointsAABBAABB(a, aabb.New(5, 6, 7, 8))
+			So(p, ShouldResemble, v.V(2, 2))
+			So(q, ShouldResemble, v.V(5, 6))
+			So(d, ShouldAlmostEqual, 25)
+
+			p, q, d = ClosestPointsAABBAABB(a, aabb.New(-4, 1, -1, 5))
+			So(p, ShouldResemble, v.V(0, 1.5))
+			So(q, ShouldResemble, v.V(-1, 1.5))
+			So(d, ShouldAlmostEqual, 1)
+
+			p, q, d = ClosestPointsAABBAABB(a, aabb.New(1, 1, 3, 3))
+			So(p, ShouldResemble, v.V(1.5, 1.5))
+			So(q, ShouldResemble, p)
+			So(d, ShouldAlmostEqual, 0)
+		})
+	})
+}
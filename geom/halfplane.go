@@ -0,0 +1,36 @@
+package geom
+
+import "github.com/oniproject/math/f"
+import "github.com/oniproject/math/v"
+
+// Points p with Dot(Normal, p) <= Offset, Normal has unit length.
+// The boundary is the line Dot(Normal, p) == Offset.
+type HalfPlane struct {
+	Normal v.Vect
+	Offset f.Float
+}
+
+// Constructs the half-plane behind the boundary through p with the given outward normal.
+func NewHalfPlane(p, normal v.Vect) HalfPlane {
+	n := v.Normalize(normal)
+	return HalfPlane{n, v.Dot(n, p)}
+}
+
+// Returns the signed distance of p from the boundary, negative inside.
+func (h HalfPlane) Dist(p v.Vect) f.Float { return v.Dot(h.Normal, p) - h.Offset }
+
+// Returns true if p is inside or on the boundary.
+func (h HalfPlane) Contains(p v.Vect) bool { return h.Dist(p) <= 0 }
+
+// Returns the boundary line, directed so that the half-plane is on its left.
+func (h HalfPlane) Line() Line {
+	return LineDir(v.Mult(h.Normal, h.Offset), v.LPerp(h.Normal))
+}
+
+// Returns the complementary half-plane sharing the boundary.
+func (h HalfPlane) Flip() HalfPlane { return HalfPlane{v.Neg(h.Normal), -h.Offset} }
+
+// Returns the orthogonal projection of p onto the boundary.
+func (h HalfPlane) Project(p v.Vect) v.Vect {
+	return v.Sub(p, v.Mult(h.Normal, h.Dist(p)))
+}
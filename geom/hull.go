@@ -0,0 +1,49 @@
+package geom
+
+import "sort"
+import "github.com/oniproject/math/v"
+
+// Returns the convex hull of points in counterclockwise order, starting
+// from the lowest of the leftmost points.
+// Duplicate and collinear points are left out, so fewer than three points
+// are returned for degenerate input. The input slice is not modified.
+//
+// Uses Andrew's monotone chain with the exact Orient predicate.
+func ConvexHull(points []v.Vect) []v.Vect {
+	ps := append([]v.Vect(nil), points...)
+	sort.Slice(ps, func(i, j int) bool {
+		if ps[i].X != ps[j].X {
+			return ps[i].X < ps[j].X
+		}
+		return ps[i].Y < ps[j].Y
+	})
+	n := 0
+	for i, p := range ps {
+		if i == 0 || p != ps[n-1] {
+			ps[n] = p
+			n++
+		}
+	}
+	ps = ps[:n]
+	if n < 3 {
+		return ps
+	}
+
+	hull := make([]v.Vect, 0, 2*n)
+	// Lower chain left to right, then the upper chain back.
+	for pass := 0; pass < 2; pass++ {
+		start := len(hull)
+		for _, p := range ps {
+			for len(hull) >= start+2 && Orient(hull[len(hull)-2], hull[len(hull)-1], p) <= 0 {
+				hull = hull[:len(hull)-1]
+			}
+			hull = append(hull, p)
+		}
+		// The last point starts the other chain.
+		hull = hull[:len(hull)-1]
+		for i, j := 0, n-1; i < j; i, j = i+1, j-1 {
+			ps[i], ps[j] = ps[j], ps[i]
+		}
+	}
+	return hull
+}
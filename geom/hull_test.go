@@ -0,0 +1,72 @@
+package geom
+
+import (
+	"github.com/oniproject/math/f"
+	"github.com/oniproject/math/v"
+	. "github.com/smartystreets/goconvey/convey"
+	"math/rand"
+	"testing"
+)
+
+func TestHull(test *testing.T) {
+	Convey("ConvexHull", test, func() {
+		Convey("Square with inner, duplicate and edge points", func() {
+			pts := []v.Vect{
+				v.V(2, 2), v.V(0, 0), v.V(4, 4), v.V(1, 3), v.V(4, 0),
+				v.V(0, 4), v.V(2, 0), v.V(4, 0), v.V(0, 2),
+			}
+			hull := ConvexHull(pts)
+			So(hull, ShouldResemble, []v.Vect{v.V(0, 0), v.V(4, 0), v.V(4, 4), v.V(0, 4)})
+			So(pts[0], ShouldResemble, v.V(2, 2))
+		})
+		Convey("Degenerate", func() {
+			So(ConvexHull(nil), ShouldBeEmpty)
+			So(ConvexHull([]v.Vect{v.V(1, 1), v.V(1, 1)}), ShouldResemble, []v.Vect{v.V(1, 1)})
+			line := []v.Vect{v.V(3, 3), v.V(1, 1), v.V(2, 2), v.V(0, 0)}
+			So(ConvexHull(line), ShouldResemble, []v.Vect{v.V(0, 0), v.V(3, 3)})
+		})
+		Convey("Nearly collinear points", func() {
+			// Points within an ULP of a line, where v.Cross misjudges turns.
+			var pts []v.Vect
+			for i := 0; i < 200; i++ {
+				x := 0.5 + f.Float(i)*0.125
+				pts = append(pts, v.V(x, x), v.V(x, f.NextAfter(x, 100)))
+			}
+			hull := ConvexHull(pts)
+			for i, a := range hull {
+				b, c := hull[(i+1)%len(hull)], hull[(i+2)%len(hull)]
+				So(Orient(a, b, c), ShouldEqual, 1)
+			}
+			for _, p := range pts {
+				for i, a := range hull {
+					So(Orient(a, hull[(i+1)%len(hull)], p), ShouldBeGreaterThanOrEqualTo, 0)
+				}
+			}
+		})
+		Convey("Random points are enclosed", func() {
+			rng := rand.New(rand.NewSource(3))
+			var pts []v.Vect
+			for i := 0; i < 500; i++ {
+				pts = append(pts, v.V(f.Float(rng.NormFloat64()), f.Float(rng.NormFloat64())))
+			}
+			hull := ConvexHull(pts)
+			So(len(hull), ShouldBeGreaterThan, 3)
+			for i, a := range hull {
+				b, c := hull[(i+1)%len(hull)], hull[(i+2)%len(hull)]
+				So(Orient(a, b, c), ShouldEqual, 1)
+			}
+			for _, p := range pts {
+				_, d := ClosestPointPolygon(p, hull)
+				So(d, ShouldEqual, 0)
+			}
+		})
+	})
+	Convey("InCircle", test, func() {
+		a, b, c := v.V(0, 0), v.V(2, 0), v.V(0, 2)
+		So(InCircle(a, b, c, v.V(1, 1)), ShouldEqual, 1)
+		So(InCircle(a, c, b, v.V(1, 1)), ShouldEqual, 1)
+		So(InCircle(a, b, c, v.V(2, 2)), ShouldEqual, 0)
+		So(InCircle(a, c, b, v.V(3, 3)), ShouldEqual, -1)
+		So(InCircle(a, v.V(1, 1), v.V(2, 2), v.V(1, 0)), ShouldEqual, 0)
+	})
+}
@@ -0,0 +1,84 @@
+package geom
+
+import "github.com/oniproject/math/f"
+import "github.com/oniproject/math/v"
+import "github.com/oniproject/math/t"
+
+// Infinite line through A and B, directed from A to B.
+// The points are kept rather than a direction so that Side classifies
+// against the line exactly as given. A and B must differ.
+type Line struct {
+	A, B v.Vect
+}
+
+// Constructs the line through a and b.
+func LineThrough(a, b v.Vect) Line { return Line{a, b} }
+
+// Constructs the line through point in the direction dir.
+// The line passes through point and the rounded point+dir.
+func LineDir(point, dir v.Vect) Line { return Line{point, v.Add(point, dir)} }
+
+// Constructs the line of the points p with Dot(normal, p) == offset.
+// The normal points to the right of the line direction.
+func LineFromNormal(normal v.Vect, offset f.Float) Line {
+	return HalfPlane{v.Normalize(normal), offset}.Line()
+}
+
+// Returns the direction of the line, B - A.
+func (l Line) Dir() v.Vect { return v.Sub(l.B, l.A) }
+
+// Returns the unit normal pointing to the right of the line and the
+// offset along it, the normal and offset form of the line.
+func (l Line) Normal() (normal v.Vect, offset f.Float) {
+	h := l.HalfPlane()
+	return h.Normal, h.Offset
+}
+
+// Returns the half-plane left of the line.
+func (l Line) HalfPlane() HalfPlane {
+	return NewHalfPlane(l.A, v.RPerp(l.Dir()))
+}
+
+// Classifies p as left (+1), right (-1) or on the line (0).
+// Exact for the line through A and B, see Orient.
+func (l Line) Side(p v.Vect) int {
+	return Orient(l.A, l.B, p)
+}
+
+// Returns the signed distance of p from the line, positive on the left.
+func (l Line) Dist(p v.Vect) f.Float {
+	d := l.Dir()
+	return v.Cross(d, v.Sub(p, l.A)) / v.Length(d)
+}
+
+// Returns the orthogonal projection of p onto the line.
+func (l Line) Project(p v.Vect) v.Vect {
+	return v.Add(l.A, v.Project(v.Sub(p, l.A), l.Dir()))
+}
+
+// Returns the mirror image of p across the line.
+func (l Line) Reflect(p v.Vect) v.Vect {
+	return v.Sub(v.Mult(l.Project(p), 2), p)
+}
+
+// Returns the transform mirroring points across the line.
+func (l Line) Reflection() t.Transform {
+	d := v.Normalize(l.Dir())
+	xx, xy, yy := 2*d.X*d.X-1, 2*d.X*d.Y, 2*d.Y*d.Y-1
+	p := l.A
+	return t.Transpose(
+		xx, xy, p.X-(xx*p.X+xy*p.Y),
+		xy, yy, p.Y-(xy*p.X+yy*p.Y),
+	)
+}
+
+// Returns the intersection point of two lines, false if they are parallel.
+func IntersectLines(l1, l2 Line) (v.Vect, bool) {
+	d1, d2 := l1.Dir(), l2.Dir()
+	denom := v.Cross(d1, d2)
+	if denom == 0 {
+		return v.Vect{}, false
+	}
+	s := v.Cross(v.Sub(l2.A, l1.A), d2) / denom
+	return v.Add(l1.A, v.Mult(d1, s)), true
+}
@@ -0,0 +1,156 @@
+package geom
+
+import (
+	"github.com/oniproject/math/f"
+	"github.com/oniproject/math/mathtest"
+	"github.com/oniproject/math/v"
+	. "github.com/smartystreets/goconvey/convey"
+	"math/rand"
+	"testing"
+)
+
+func TestLine(test *testing.T) {
+	Convey("Lines and segments", test, func() {
+		Convey("Orient", func() {
+			So(Orient(v.V(0, 0), v.V(1, 0), v.V(0, 1)), ShouldEqual, 1)
+			So(Orient(v.V(0, 0), v.V(1, 0), v.V(0, -1)), ShouldEqual, -1)
+			So(Orient(v.V(0, 0), v.V(1, 1), v.V(3, 3)), ShouldEqual, 0)
+
+			// Nearly collinear points where the sign of v.Cross is unreliable.
+			a, b := v.V(0.5, 0.5), v.V(12, 12)
+			for i := 0; i < 64; i++ {
+				c := v.V(24, 24+f.Float(i-32)*f.Epsilon*8)
+				want := 0
+				if c.Y > 24 {
+					want = 1
+				} else if c.Y < 24 {
+					want = -1
+				}
+				So(Orient(a, b, c), ShouldEqual, want)
+			}
+		})
+		Convey("Line", func() {
+			l := LineThrough(v.V(0, 1), v.V(2, 1))
+			So(l.Side(v.V(5, 3)), ShouldEqual, 1)
+			So(l.Side(v.V(5, -3)), ShouldEqual, -1)
+			So(l.Side(v.V(-5, 1)), ShouldEqual, 0)
+			So(l.Dist(v.V(5, 3)), ShouldAlmostEqual, 2)
+			So(l.Dist(v.V(5, -3)), ShouldAlmostEqual, -4)
+			So(l.Project(v.V(5, 3)), ShouldResemble, v.V(5, 1))
+			So(l.Reflect(v.V(5, 3)), ShouldResemble, v.V(5, -1))
+
+			n, d := l.Normal()
+			So(n, ShouldResemble, v.V(0, -1))
+			So(d, ShouldEqual, -1)
+			So(l.HalfPlane().Contains(v.V(0, 2)), ShouldBeTrue)
+			So(l.HalfPlane().Contains(v.V(0, 0)), ShouldBeFalse)
+
+			dir := LineDir(v.V(0, 1), v.V(2, 0))
+			So(dir, ShouldResemble, l)
+			So(dir.Dir(), ShouldResemble, v.V(2, 0))
+
+			back := LineFromNormal(v.V(0, -2), -1)
+			So(back.Side(v.V(0, 2)), ShouldEqual, 1)
+			So(back.Side(v.V(7, 1)), ShouldEqual, 0)
+		})
+		Convey("Line.Side agrees with Segment.Side near the line", func() {
+			a, b := v.V(9.297015, 64.583336), v.V(31.188555, 44.846436)
+			p := v.V(19.404728, 55.47046)
+			So(LineThrough(a, b).Side(p), ShouldEqual, Segment{a, b}.Side(p))
+
+			rng := rand.New(rand.NewSource(6))
+			for i := 0; i < 2000; i++ {
+				a := v.V(f.Float(rng.Float64()*100), f.Float(rng.Float64()*100))
+				b := v.V(f.Float(rng.Float64()*100), f.Float(rng.Float64()*100))
+				p := v.Lerp(a, b, f.Float(rng.Float64()*3-1))
+				p.Y = f.NextAfter(p.Y, f.Float(rng.Intn(2)*200-100))
+				So(LineThrough(a, b).Side(p), ShouldEqual, Segment{a, b}.Side(p))
+			}
+		})
+		Convey("Reflection", func() {
+			l := LineThrough(v.V(1, 0), v.V(2, 1))
+			r := l.Reflection()
+			for _, p := range []v.Vect{v.V(0, 0), v.V(3, -2), v.V(-4, 5)} {
+				So(r.Point(p), mathtest.ShouldApproxEqual, l.Reflect(p))
+				// A reflection is its own inverse.
+				q := r.Point(p)
+				So(r.Point(q), mathtest.ShouldApproxEqual, p)
+			}
+			So(r.Point(v.V(0, 0)), mathtest.ShouldApproxEqual, v.V(1, -1))
+			So(r.A*r.D-r.B*r.C, ShouldAlmostEqual, -1, 1e-5)
+		})
+		Convey("IntersectLines", func() {
+			p, ok := IntersectLines(LineThrough(v.V(0, 0), v.V(1, 1)), LineThrough(v.V(0, 4), v.V(1, 3)))
+			So(ok, ShouldBeTrue)
+			So(p, ShouldResemble, v.V(2, 2))
+			_, ok = IntersectLines(LineThrough(v.V(0, 0), v.V(1, 1)), LineThrough(v.V(0, 1), v.V(1, 2)))
+			So(ok, ShouldBeFalse)
+		})
+		Convey("Segment", func() {
+			s := Segment{v.V(0, 0), v.V(4, 0)}
+			So(s.Length(), ShouldEqual, 4)
+			So(s.Side(v.V(9, 1)), ShouldEqual, 1)
+			So(s.Project(v.V(9, 1)), ShouldResemble, v.V(4, 0))
+			So(s.Line().Project(v.V(9, 1)), ShouldResemble, v.V(9, 0))
+			So(s.Contains(v.V(3, 0)), ShouldBeTrue)
+			So(s.Contains(v.V(5, 0)), ShouldBeFalse)
+			So(s.Contains(v.V(3, 1)), ShouldBeFalse)
+		})
+		Convey("IntersectSegments", func() {
+			s := Segment{v.V(0, 0), v.V(4, 4)}
+			cases := []struct {
+				other Segment
+				want  Segment
+				ok    bool
+			}{
+				// Crossing.
+				{Segment{v.V(0, 4), v.V(4, 0)}, Segment{v.V(2, 2), v.V(2, 2)}, true},
+				// Touching at an endpoint.
+				{Segment{v.V(1, 1), v.V(3, 0)}, Segment{v.V(1, 1), v.V(1, 1)}, true},
+				{Segment{v.V(4, 4), v.V(5, 0)}, Segment{v.V(4, 4), v.V(4, 4)}, true},
+				// Apart.
+				{Segment{v.V(3, 0), v.V(5, 1)}, Segment{}, false},
+				{Segment{v.V(0, 1), v.V(4, 5)}, Segment{}, false},
+				// Collinear.
+				{Segment{v.V(6, 6), v.V(2, 2)}, Segment{v.V(2, 2), v.V(4, 4)}, true},
+				{Segment{v.V(1, 1), v.V(2, 2)}, Segment{v.V(1, 1), v.V(2, 2)}, true},
+				{Segment{v.V(-2, -2), v.V(0, 0)}, Segment{v.V(0, 0), v.V(0, 0)}, true},
+				{Segment{v.V(5, 5), v.V(6, 6)}, Segment{}, false},
+				// Degenerate.
+				{Segment{v.V(3, 3), v.V(3, 3)}, Segment{v.V(3, 3), v.V(3, 3)}, true},
+				{Segment{v.V(3, 2), v.V(3, 2)}, Segment{}, false},
+			}
+			for _, c := range cases {
+				got, ok := IntersectSegments(s, c.other)
+				So(ok, ShouldEqual, c.ok)
+				if ok {
+					So(got, ShouldResemble, c.want)
+				}
+				// Symmetric up to the direction of the overlap.
+				got, ok = IntersectSegments(c.other, s)
+				So(ok, ShouldEqual, c.ok)
+				if ok && got.A != c.want.A {
+					got = Segment{got.B, got.A}
+				}
+				if ok {
+					So(got, ShouldResemble, c.want)
+				}
+			}
+
+			p := Segment{v.V(2, 2), v.V(2, 2)}
+			got, ok := IntersectSegments(p, p)
+			So(ok, ShouldBeTrue)
+			So(got, ShouldResemble, p)
+			_, ok = IntersectSegments(p, Segment{v.V(1, 2), v.V(1, 2)})
+			So(ok, ShouldBeFalse)
+		})
+		Convey("HalfPlane", func() {
+			h := NewHalfPlane(v.V(0, 2), v.V(0, 1))
+			So(h.Line().Side(v.V(0, 0)), ShouldEqual, 1)
+			So(h.Line().Side(v.V(5, 2)), ShouldEqual, 0)
+			So(h.Flip().Contains(v.V(0, 3)), ShouldBeTrue)
+			So(h.Flip().Contains(v.V(0, 1)), ShouldBeFalse)
+			So(h.Project(v.V(3, -1)), ShouldResemble, v.V(3, 2))
+		})
+	})
+}
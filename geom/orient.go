@@ -0,0 +1,29 @@
+package geom
+
+import "github.com/oniproject/math/v"
+import "github.com/oniproject/math/robust"
+
+// Orientation of the triangle abc.
+// Returns +1 if c is left of the directed line from a to b (counterclockwise),
+// -1 if it is right (clockwise) and 0 if the points are collinear.
+// Exact for any input, unlike the sign of v.Cross, see robust.Orient2D.
+func Orient(a, b, c v.Vect) int {
+	return sign(robust.Orient2D(a, b, c))
+}
+
+// Returns +1 if d is inside the circle through a, b and c, -1 if it is
+// outside and 0 if it is on the circle, in either winding of a, b and c.
+// Collinear a, b and c give 0. Exact for any input, see robust.InCircle.
+func InCircle(a, b, c, d v.Vect) int {
+	return sign(robust.InCircle(a, b, c, d)) * Orient(a, b, c)
+}
+
+func sign(x float64) int {
+	switch {
+	case x > 0:
+		return +1
+	case x < 0:
+		return -1
+	}
+	return 0
+}
@@ -0,0 +1,215 @@
+// Geometric primitives and queries built on v.Vect: rays, lines,
+// segments and half-planes, with intersection and distance tests.
+package geom
+
+import "github.com/oniproject/math/f"
+import "github.com/oniproject/math/v"
+import "github.com/oniproject/math/aabb"
+
+// Half-line starting at Origin in the unit direction Dir, up to MaxDist.
+type Ray struct {
+	Origin, Dir v.Vect
+	MaxDist     f.Float
+}
+
+// Result of a ray intersection.
+// T is the distance along the ray, Point the hit point and Normal the unit
+// surface normal facing the ray.
+// A ray starting inside a solid hits it at T = 0 with a zero Normal.
+type Hit struct {
+	Point, Normal v.Vect
+	T             f.Float
+}
+
+// Constructs a ray, normalizing dir. Use f.Inf for an unbounded ray.
+func NewRay(origin, dir v.Vect, maxDist f.Float) Ray {
+	return Ray{origin, v.Normalize(dir), maxDist}
+}
+
+// Constructs a ray from a to b, as long as the segment between them.
+func RayTo(a, b v.Vect) Ray {
+	return Ray{a, v.Normalize(v.Sub(b, a)), v.Dist(a, b)}
+}
+
+// Returns the point at distance t along the ray.
+func (r Ray) At(t f.Float) v.Vect { return v.Add(r.Origin, v.Mult(r.Dir, t)) }
+
+func (r Ray) hit(t f.Float, normal v.Vect) Hit {
+	return Hit{r.At(t), normal, t}
+}
+
+func (r Ray) inside() Hit { return Hit{r.Origin, v.Zero(), 0} }
+
+// Slab test of one axis, narrowing [tmin, tmax]. Returns false on a miss.
+// The normals of the faces where the range was narrowed are kept in nmin and nmax.
+func slab(o, d, lo, hi f.Float, axis v.Vect, tmin, tmax *f.Float, nmin, nmax *v.Vect) bool {
+	if d == 0 {
+		return lo <= o && o <= hi
+	}
+	t1, t2 := (lo-o)/d, (hi-o)/d
+	n1, n2 := v.Neg(axis), axis
+	if t1 > t2 {
+		t1, t2 = t2, t1
+		n1, n2 = n2, n1
+	}
+	if t1 > *tmin {
+		*tmin, *nmin = t1, n1
+	}
+	if t2 < *tmax {
+		*tmax, *nmax = t2, n2
+	}
+	return *tmin <= *tmax
+}
+
+// Intersects the ray with a bounding box.
+// Returns where the ray enters and leaves the box, the exit may lie beyond MaxDist.
+// The exit normal points out of the box.
+func (r Ray) IntersectAABB(bb aabb.AABB) (enter, exit Hit, ok bool) {
+	tmin, tmax := f.Float(0), f.Inf
+	var nmin, nmax v.Vect
+	if !slab(r.Origin.X, r.Dir.X, bb.L, bb.R, v.V(1, 0), &tmin, &tmax, &nmin, &nmax) ||
+		!slab(r.Origin.Y, r.Dir.Y, bb.B, bb.T, v.V(0, 1), &tmin, &tmax, &nmin, &nmax) ||
+		tmin > r.MaxDist {
+		return
+	}
+	return r.hit(tmin, nmin), r.hit(tmax, nmax), true
+}
+
+// Intersects the ray with a solid circle.
+func (r Ray) IntersectCircle(center v.Vect, radius f.Float) (Hit, bool) {
+	m := v.Sub(r.Origin, center)
+	c := v.LengthSq(m) - radius*radius
+	if c <= 0 {
+		return r.inside(), true
+	}
+	b := v.Dot(m, r.Dir)
+	disc := b*b - c
+	if b > 0 || disc < 0 {
+		return Hit{}, false
+	}
+	t := -b - f.Sqrt(disc)
+	if t > r.MaxDist {
+		return Hit{}, false
+	}
+	p := r.At(t)
+	return Hit{p, v.Normalize(v.Sub(p, center)), t}, true
+}
+
+// Intersects the ray with the segment from a to b.
+// The normal faces the ray, a collinear ray hits the nearest end with the normal -Dir.
+func (r Ray) IntersectSegment(a, b v.Vect) (Hit, bool) {
+	e := v.Sub(b, a)
+	ao := v.Sub(a, r.Origin)
+	denom := v.Cross(r.Dir, e)
+
+	if denom == 0 {
+		if v.Cross(ao, r.Dir) != 0 {
+			return Hit{}, false
+		}
+		// Collinear, hit the nearest part of the segment ahead.
+		ta, tb := v.Dot(ao, r.Dir), v.Dot(v.Sub(b, r.Origin), r.Dir)
+		t := f.Max(f.Min(ta, tb), 0)
+		if f.Max(ta, tb) < 0 || t > r.MaxDist {
+			return Hit{}, false
+		}
+		return r.hit(t, v.Neg(r.Dir)), true
+	}
+
+	t := v.Cross(ao, e) / denom
+	s := v.Cross(ao, r.Dir) / denom
+	if t < 0 || t > r.MaxDist || s < 0 || s > 1 {
+		return Hit{}, false
+	}
+	n := v.Normalize(v.LPerp(e))
+	if v.Dot(n, r.Dir) > 0 {
+		n = v.Neg(n)
+	}
+	return r.hit(t, n), true
+}
+
+// Intersects the ray with a solid capsule, the segment from a to b inflated by radius.
+func (r Ray) IntersectCapsule(a, b v.Vect, radius f.Float) (Hit, bool) {
+	if _, d := ClosestPointSegment(r.Origin, a, b); d <= radius*radius {
+		return r.inside(), true
+	}
+
+	best, found := Hit{T: f.Inf}, false
+	try := func(h Hit, ok bool) {
+		if ok && h.T < best.T {
+			best, found = h, true
+		}
+	}
+	try(r.IntersectCircle(a, radius))
+	try(r.IntersectCircle(b, radius))
+	if a != b {
+		side := v.Mult(v.Normalize(v.LPerp(v.Sub(b, a))), radius)
+		try(r.IntersectSegment(v.Add(a, side), v.Add(b, side)))
+		try(r.IntersectSegment(v.Sub(a, side), v.Sub(b, side)))
+	}
+	return best, found
+}
+
+// Intersects the ray with a solid convex polygon with counterclockwise vertices.
+func (r Ray) IntersectPolygon(verts []v.Vect) (Hit, bool) {
+	if len(verts) < 3 {
+		return Hit{}, false
+	}
+	tmin, tmax := f.Float(0), r.MaxDist
+	var normal v.Vect
+	for i, a := range verts {
+		b := verts[(i+1)%len(verts)]
+		// Outward normal of a counterclockwise edge.
+		n := v.Normalize(v.RPerp(v.Sub(b, a)))
+		num := v.Dot(n, v.Sub(a, r.Origin))
+		den := v.Dot(n, r.Dir)
+		switch {
+		case den == 0:
+			if num < 0 {
+				return Hit{}, false
+			}
+		case den < 0:
+			if t := num / den; t > tmin {
+				tmin, normal = t, n
+			}
+		default:
+			tmax = f.Min(tmax, num/den)
+		}
+		if tmin > tmax {
+			return Hit{}, false
+		}
+	}
+	return r.hit(tmin, normal), true
+}
+
+// Intersects the ray with the boundary line of a half-plane, from either side.
+func (r Ray) IntersectPlane(h HalfPlane) (Hit, bool) {
+	den := v.Dot(h.Normal, r.Dir)
+	if den == 0 {
+		return Hit{}, false
+	}
+	t := (h.Offset - v.Dot(h.Normal, r.Origin)) / den
+	if t < 0 || t > r.MaxDist {
+		return Hit{}, false
+	}
+	if den > 0 {
+		return r.hit(t, v.Neg(h.Normal)), true
+	}
+	return r.hit(t, h.Normal), true
+}
+
+// Intersects the ray with a solid half-plane.
+func (r Ray) IntersectHalfPlane(h HalfPlane) (Hit, bool) {
+	dist := v.Dot(h.Normal, r.Origin) - h.Offset
+	if dist <= 0 {
+		return r.inside(), true
+	}
+	den := v.Dot(h.Normal, r.Dir)
+	if den >= 0 {
+		return Hit{}, false
+	}
+	t := -dist / den
+	if t > r.MaxDist {
+		return Hit{}, false
+	}
+	return r.hit(t, h.Normal), true
+}
@@ -0,0 +1,168 @@
+package geom
+
+import (
+	"github.com/oniproject/math/aabb"
+	"github.com/oniproject/math/f"
+	"github.com/oniproject/math/mathtest"
+	"github.com/oniproject/math/v"
+	. "github.com/smartystreets/goconvey/convey"
+	"testing"
+)
+
+func shouldHit(h Hit, ok bool, point, normal v.Vect, t f.Float) {
+	So(ok, ShouldBeTrue)
+	So(h.Point, mathtest.ShouldApproxEqual, point)
+	So(h.Normal, mathtest.ShouldApproxEqual, normal)
+	So(h.T, mathtest.ShouldApproxEqual, t)
+}
+
+func TestRay(test *testing.T) {
+	Convey("Ray", test, func() {
+		r := NewRay(v.V(0, 0), v.V(2, 0), 10)
+		So(r.Dir, ShouldResemble, v.V(1, 0))
+		So(r.At(3), ShouldResemble, v.V(3, 0))
+
+		to := RayTo(v.V(1, 1), v.V(4, 5))
+		So(to.MaxDist, ShouldAlmostEqual, 5)
+		So(to.At(to.MaxDist), mathtest.ShouldApproxEqual, v.V(4, 5))
+
+		Convey("IntersectAABB", func() {
+			bb := aabb.New(2, -1, 4, 1)
+			enter, exit, ok := r.IntersectAABB(bb)
+			shouldHit(enter, ok, v.V(2, 0), v.V(-1, 0), 2)
+			shouldHit(exit, ok, v.V(4, 0), v.V(1, 0), 4)
+
+			diag := NewRay(v.V(0, -2), v.V(1, 1), f.Inf)
+			enter, exit, ok = diag.IntersectAABB(aabb.New(1, 0, 5, 2))
+			shouldHit(enter, ok, v.V(2, 0), v.V(0, -1), 2*f.Sqrt(2))
+			So(exit.Normal, ShouldResemble, v.V(0, 1))
+
+			inside := NewRay(v.V(3, 0), v.V(0, 1), 10)
+			enter, exit, ok = inside.IntersectAABB(bb)
+			shouldHit(enter, ok, v.V(3, 0), v.Zero(), 0)
+			So(exit.T, ShouldAlmostEqual, 1)
+
+			_, _, ok = NewRay(v.V(0, 0), v.V(1, 0), 1.5).IntersectAABB(bb)
+			So(ok, ShouldBeFalse)
+			_, _, ok = NewRay(v.V(0, 2), v.V(1, 0), 10).IntersectAABB(bb)
+			So(ok, ShouldBeFalse)
+			_, _, ok = NewRay(v.V(5, 0), v.V(1, 0), 10).IntersectAABB(bb)
+			So(ok, ShouldBeFalse)
+		})
+		Convey("IntersectAABB agrees with SegmentQuery", func() {
+			bb := aabb.New(-1, -2, 3, 1)
+			for i := 0; i < 64; i++ {
+				a := v.FromAngle(f.Angle(i) * 0.1)
+				from, to := v.Mult(a, 5), v.Mult(a, -5)
+				to = v.Add(to, v.V(0, f.Float(i%5)-2))
+				_, _, ok := RayTo(from, to).IntersectAABB(bb)
+				So(ok, ShouldEqual, bb.SegmentQuery(from, to) <= 1)
+			}
+		})
+		Convey("IntersectCircle", func() {
+			h, ok := r.IntersectCircle(v.V(5, 0), 1)
+			shouldHit(h, ok, v.V(4, 0), v.V(-1, 0), 4)
+
+			h, ok = NewRay(v.V(0, 1), v.V(1, 0), 10).IntersectCircle(v.V(5, 0), 1)
+			shouldHit(h, ok, v.V(5, 1), v.V(0, 1), 5)
+
+			h, ok = r.IntersectCircle(v.V(0, 0.5), 1)
+			shouldHit(h, ok, v.V(0, 0), v.Zero(), 0)
+
+			_, ok = r.IntersectCircle(v.V(5, 2), 1)
+			So(ok, ShouldBeFalse)
+			_, ok = r.IntersectCircle(v.V(-5, 0), 1)
+			So(ok, ShouldBeFalse)
+			_, ok = r.IntersectCircle(v.V(12, 0), 1)
+			So(ok, ShouldBeFalse)
+		})
+		Convey("IntersectSegment", func() {
+			h, ok := r.IntersectSegment(v.V(3, -1), v.V(3, 1))
+			shouldHit(h, ok, v.V(3, 0), v.V(-1, 0), 3)
+			h, ok = r.IntersectSegment(v.V(3, 1), v.V(3, -1))
+			shouldHit(h, ok, v.V(3, 0), v.V(-1, 0), 3)
+
+			_, ok = r.IntersectSegment(v.V(3, 1), v.V(3, 2))
+			So(ok, ShouldBeFalse)
+			_, ok = r.IntersectSegment(v.V(-3, 1), v.V(-3, -1))
+			So(ok, ShouldBeFalse)
+			_, ok = r.IntersectSegment(v.V(0, 1), v.V(5, 1))
+			So(ok, ShouldBeFalse)
+
+			Convey("Collinear", func() {
+				h, ok := r.IntersectSegment(v.V(6, 0), v.V(2, 0))
+				shouldHit(h, ok, v.V(2, 0), v.V(-1, 0), 2)
+				h, ok = r.IntersectSegment(v.V(-1, 0), v.V(2, 0))
+				shouldHit(h, ok, v.V(0, 0), v.V(-1, 0), 0)
+				_, ok = r.IntersectSegment(v.V(-3, 0), v.V(-1, 0))
+				So(ok, ShouldBeFalse)
+			})
+		})
+		Convey("IntersectCapsule", func() {
+			// Horizontal capsule, hit from the left end.
+			h, ok := r.IntersectCapsule(v.V(4, 0), v.V(8, 0), 1)
+			shouldHit(h, ok, v.V(3, 0), v.V(-1, 0), 3)
+
+			// Vertical capsule, hit on the side.
+			h, ok = r.IntersectCapsule(v.V(5, -3), v.V(5, 3), 1)
+			shouldHit(h, ok, v.V(4, 0), v.V(-1, 0), 4)
+
+			// Falls between the side segments of a degenerate capsule.
+			h, ok = r.IntersectCapsule(v.V(5, 0), v.V(5, 0), 2)
+			shouldHit(h, ok, v.V(3, 0), v.V(-1, 0), 3)
+
+			h, ok = r.IntersectCapsule(v.V(-1, 0), v.V(1, 0), 0.5)
+			shouldHit(h, ok, v.V(0, 0), v.Zero(), 0)
+
+			_, ok = r.IntersectCapsule(v.V(5, 2), v.V(9, 2), 1)
+			So(ok, ShouldBeFalse)
+		})
+		Convey("IntersectPolygon", func() {
+			square := []v.Vect{v.V(2, -1), v.V(4, -1), v.V(4, 1), v.V(2, 1)}
+			h, ok := r.IntersectPolygon(square)
+			shouldHit(h, ok, v.V(2, 0), v.V(-1, 0), 2)
+
+			diamond := []v.Vect{v.V(3, -1), v.V(4, 0), v.V(3, 1), v.V(2, 0)}
+			h, ok = NewRay(v.V(0, 0.5), v.V(1, 0), 10).IntersectPolygon(diamond)
+			s := 1 / f.Sqrt(2)
+			shouldHit(h, ok, v.V(2.5, 0.5), v.V(-s, s), 2.5)
+
+			h, ok = NewRay(v.V(3, 0), v.V(0, 1), 10).IntersectPolygon(square)
+			shouldHit(h, ok, v.V(3, 0), v.Zero(), 0)
+
+			_, ok = NewRay(v.V(0, 1.5), v.V(1, 0), 10).IntersectPolygon(square)
+			So(ok, ShouldBeFalse)
+			_, ok = NewRay(v.V(0, 0), v.V(1, 0), 1).IntersectPolygon(square)
+			So(ok, ShouldBeFalse)
+			_, ok = NewRay(v.V(5, 0), v.V(1, 0), 10).IntersectPolygon(square)
+			So(ok, ShouldBeFalse)
+			_, ok = r.IntersectPolygon(square[:2])
+			So(ok, ShouldBeFalse)
+		})
+		Convey("IntersectPlane and IntersectHalfPlane", func() {
+			h := NewHalfPlane(v.V(5, 0), v.V(-2, 0))
+			So(h, ShouldResemble, HalfPlane{v.V(-1, 0), -5})
+			So(h.Contains(v.V(6, 3)), ShouldBeTrue)
+			So(h.Contains(v.V(4, 3)), ShouldBeFalse)
+			So(h.Dist(v.V(7, 0)), ShouldEqual, -2)
+
+			hit, ok := r.IntersectPlane(h)
+			shouldHit(hit, ok, v.V(5, 0), v.V(-1, 0), 5)
+			hit, ok = r.IntersectHalfPlane(h)
+			shouldHit(hit, ok, v.V(5, 0), v.V(-1, 0), 5)
+
+			back := NewRay(v.V(8, 0), v.V(-1, 0), 10)
+			hit, ok = back.IntersectPlane(h)
+			shouldHit(hit, ok, v.V(5, 0), v.V(1, 0), 3)
+			hit, ok = back.IntersectHalfPlane(h)
+			shouldHit(hit, ok, v.V(8, 0), v.Zero(), 0)
+
+			_, ok = NewRay(v.V(0, 0), v.V(0, 1), 10).IntersectPlane(h)
+			So(ok, ShouldBeFalse)
+			_, ok = NewRay(v.V(0, 0), v.V(-1, 0), 10).IntersectHalfPlane(h)
+			So(ok, ShouldBeFalse)
+			_, ok = NewRay(v.V(0, 0), v.V(1, 0), 4).IntersectHalfPlane(h)
+			So(ok, ShouldBeFalse)
+		})
+	})
+}
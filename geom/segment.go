@@ -0,0 +1,97 @@
+package geom
+
+import "github.com/oniproject/math/f"
+import "github.com/oniproject/math/v"
+
+// Line segment from A to B.
+type Segment struct {
+	A, B v.Vect
+}
+
+// Returns the length of the segment.
+func (s Segment) Length() f.Float { return v.Dist(s.A, s.B) }
+
+// Returns the line through the segment.
+func (s Segment) Line() Line { return LineThrough(s.A, s.B) }
+
+// Classifies p as left (+1), right (-1) or on the line through the segment (0).
+func (s Segment) Side(p v.Vect) int { return Orient(s.A, s.B, p) }
+
+// Returns the point of the segment closest to p.
+func (s Segment) Project(p v.Vect) v.Vect {
+	q, _ := ClosestPointSegment(p, s.A, s.B)
+	return q
+}
+
+// Returns true if p lies on the segment, using the exact Orient predicate.
+func (s Segment) Contains(p v.Vect) bool {
+	return Orient(s.A, s.B, p) == 0 && inRange(p.X, s.A.X, s.B.X) && inRange(p.Y, s.A.Y, s.B.Y)
+}
+
+func inRange(x, a, b f.Float) bool {
+	return f.Min(a, b) <= x && x <= f.Max(a, b)
+}
+
+// Returns the intersection of two segments.
+// Crossing segments intersect in a single point returned as a degenerate
+// segment, collinear segments in their overlap.
+// Endpoints touching the other segment are returned exactly.
+func IntersectSegments(s1, s2 Segment) (Segment, bool) {
+	o1, o2 := Orient(s1.A, s1.B, s2.A), Orient(s1.A, s1.B, s2.B)
+	o3, o4 := Orient(s2.A, s2.B, s1.A), Orient(s2.A, s2.B, s1.B)
+
+	if o1 == 0 && o2 == 0 && o3 == 0 && o4 == 0 {
+		return collinearOverlap(s1, s2)
+	}
+	if o1*o2 > 0 || o3*o4 > 0 {
+		return Segment{}, false
+	}
+
+	var p v.Vect
+	switch {
+	case o1 == 0:
+		p = s2.A
+	case o2 == 0:
+		p = s2.B
+	case o3 == 0:
+		p = s1.A
+	case o4 == 0:
+		p = s1.B
+	default:
+		p, _ = IntersectLines(s1.Line(), s2.Line())
+	}
+	return Segment{p, p}, true
+}
+
+// Overlap of two segments on a common line.
+func collinearOverlap(s1, s2 Segment) (Segment, bool) {
+	d := v.Sub(s1.B, s1.A)
+	if d == (v.Vect{}) {
+		d = v.Sub(s2.B, s2.A)
+	}
+	if d == (v.Vect{}) {
+		return Segment{s1.A, s1.A}, s1.A == s2.A
+	}
+
+	// Order the endpoints of each segment along d and keep the inner ones.
+	key := func(p v.Vect) f.Float { return v.Dot(v.Sub(p, s1.A), d) }
+	lo1, hi1 := s1.A, s1.B
+	if key(lo1) > key(hi1) {
+		lo1, hi1 = hi1, lo1
+	}
+	lo2, hi2 := s2.A, s2.B
+	if key(lo2) > key(hi2) {
+		lo2, hi2 = hi2, lo2
+	}
+	lo, hi := lo1, hi1
+	if key(lo2) > key(lo) {
+		lo = lo2
+	}
+	if key(hi2) < key(hi) {
+		hi = hi2
+	}
+	if key(lo) > key(hi) {
+		return Segment{}, false
+	}
+	return Segment{lo, hi}, true
+}
@@ -0,0 +1,127 @@
+package grid
+
+import (
+	"github.com/oniproject/math/aabb"
+	"github.com/oniproject/math/v"
+	. "github.com/smartystreets/goconvey/convey"
+	"image"
+	"testing"
+)
+
+func TestIVect(test *testing.T) {
+	Convey("Integer vectors", test, func() {
+		a, b := IV(1, 2), IV(4, -2)
+		Convey("Arithmetic", func() {
+			So(Add(a, b), ShouldResemble, IV(5, 0))
+			So(Sub(a, b), ShouldResemble, IV(-3, 4))
+			So(Neg(a), ShouldResemble, IV(-1, -2))
+			So(Mult(a, 3), ShouldResemble, IV(3, 6))
+			So(Dot(a, b), ShouldEqual, 0)
+			So(Cross(a, b), ShouldEqual, -10)
+		})
+		Convey("Distances", func() {
+			So(Manhattan(a, b), ShouldEqual, 7)
+			So(Chebyshev(a, b), ShouldEqual, 4)
+			So(Manhattan(a, a), ShouldEqual, 0)
+		})
+		Convey("Neighbors", func() {
+			n4 := Neighbors4(a)
+			So(n4[0], ShouldResemble, IV(2, 2))
+			So(n4[3], ShouldResemble, IV(1, 1))
+			for _, n := range n4 {
+				So(Manhattan(a, n), ShouldEqual, 1)
+			}
+			seen := map[IVect]bool{}
+			for _, n := range Neighbors8(a) {
+				So(Chebyshev(a, n), ShouldEqual, 1)
+				seen[n] = true
+			}
+			So(len(seen), ShouldEqual, 8)
+		})
+		Convey("Conversions", func() {
+			p := v.V(-1.5, 2.5)
+			So(Floor(p), ShouldResemble, IV(-2, 2))
+			So(Round(p), ShouldResemble, IV(-2, 3))
+			So(Ceil(p), ShouldResemble, IV(-1, 3))
+			So(Floor(v.V(-0.1, 0.9)), ShouldResemble, IV(-1, 0))
+			So(a.Vect(), ShouldResemble, v.V(1, 2))
+			So(a.Center(), ShouldResemble, v.V(1.5, 2.5))
+			So(Floor(a.Center()), ShouldResemble, a)
+			So(a.Point(), ShouldResemble, image.Pt(1, 2))
+			So(FromPoint(image.Pt(1, 2)), ShouldResemble, a)
+		})
+	})
+}
+
+func TestIRect(test *testing.T) {
+	Convey("Integer rectangles", test, func() {
+		r := Rect(4, 3, 0, 1)
+		Convey("Construction", func() {
+			So(r, ShouldResemble, IRect{IV(0, 1), IV(4, 3)})
+			So(r.Dx(), ShouldEqual, 4)
+			So(r.Dy(), ShouldEqual, 2)
+			So(r.Size(), ShouldResemble, IV(4, 2))
+			So(r.Area(), ShouldEqual, 8)
+			So(r.Empty(), ShouldBeFalse)
+			So(IRect{}.Empty(), ShouldBeTrue)
+			So(IRect{IV(2, 2), IV(1, 5)}.Area(), ShouldEqual, 0)
+		})
+		Convey("Contains", func() {
+			So(r.Contains(IV(0, 1)), ShouldBeTrue)
+			So(r.Contains(IV(3, 2)), ShouldBeTrue)
+			So(r.Contains(IV(4, 2)), ShouldBeFalse)
+			So(r.ContainsRect(Rect(1, 1, 2, 2)), ShouldBeTrue)
+			So(r.ContainsRect(Rect(1, 1, 5, 2)), ShouldBeFalse)
+			So(r.ContainsRect(IRect{}), ShouldBeTrue)
+		})
+		Convey("Intersect and Union", func() {
+			s := Rect(2, 2, 6, 6)
+			So(r.Overlaps(s), ShouldBeTrue)
+			So(r.Intersect(s), ShouldResemble, Rect(2, 2, 4, 3))
+			So(r.Union(s), ShouldResemble, Rect(0, 1, 6, 6))
+			far := Rect(10, 10, 11, 11)
+			So(r.Overlaps(far), ShouldBeFalse)
+			So(r.Intersect(far), ShouldResemble, IRect{})
+			So(r.Union(IRect{}), ShouldResemble, r)
+			// Touching edges share no cells.
+			So(r.Overlaps(Rect(4, 1, 5, 3)), ShouldBeFalse)
+		})
+		Convey("Offset, Inset and Each", func() {
+			So(r.Offset(IV(1, -1)), ShouldResemble, Rect(1, 0, 5, 2))
+			So(Rect(0, 0, 4, 4).Inset(1), ShouldResemble, Rect(1, 1, 3, 3))
+			So(Rect(0, 0, 4, 4).Inset(-1), ShouldResemble, Rect(-1, -1, 5, 5))
+			So(Rect(0, 0, 4, 2).Inset(2).Empty(), ShouldBeTrue)
+
+			var cells []IVect
+			r.Each(func(p IVect) bool {
+				cells = append(cells, p)
+				return true
+			})
+			So(len(cells), ShouldEqual, 8)
+			So(cells[0], ShouldResemble, IV(0, 1))
+			So(cells[7], ShouldResemble, IV(3, 2))
+
+			count := 0
+			r.Each(func(p IVect) bool {
+				count++
+				return count < 3
+			})
+			So(count, ShouldEqual, 3)
+		})
+		Convey("AABB", func() {
+			So(r.AABB(), ShouldResemble, aabb.New(0, 1, 4, 3))
+			So(r.AABBInclusive(), ShouldResemble, aabb.New(0, 1, 3, 2))
+			So(RectFromAABB(r.AABB()), ShouldResemble, r)
+			So(RectFromAABBInclusive(r.AABBInclusive()), ShouldResemble, r)
+
+			bb := aabb.New(-0.5, 0.2, 1.5, 2)
+			So(RectFromAABB(bb), ShouldResemble, Rect(-1, 0, 2, 2))
+			So(RectFromAABBInclusive(bb), ShouldResemble, Rect(0, 1, 2, 3))
+		})
+		Convey("image.Rectangle", func() {
+			So(r.Rectangle(), ShouldResemble, image.Rect(0, 1, 4, 3))
+			So(FromRectangle(image.Rect(0, 1, 4, 3)), ShouldResemble, r)
+			So(r.Rectangle().Dx(), ShouldEqual, r.Dx())
+		})
+	})
+}
@@ -0,0 +1,149 @@
+package grid
+
+import "image"
+import "github.com/oniproject/math/f"
+import "github.com/oniproject/math/v"
+import "github.com/oniproject/math/aabb"
+
+// Rectangle of cells from Min inclusive to Max exclusive, like image.Rectangle.
+// It is well-formed if Min.X <= Max.X and Min.Y <= Max.Y.
+type IRect struct {
+	Min, Max IVect
+}
+
+// Constructs a well-formed rectangle, swapping the coordinates if needed.
+func Rect(x0, y0, x1, y1 int32) IRect {
+	if x0 > x1 {
+		x0, x1 = x1, x0
+	}
+	if y0 > y1 {
+		y0, y1 = y1, y0
+	}
+	return IRect{IVect{x0, y0}, IVect{x1, y1}}
+}
+
+// Returns the width.
+func (r IRect) Dx() int32 { return r.Max.X - r.Min.X }
+
+// Returns the height.
+func (r IRect) Dy() int32 { return r.Max.Y - r.Min.Y }
+
+// Returns the width and height.
+func (r IRect) Size() IVect { return Sub(r.Max, r.Min) }
+
+// Returns the number of cells.
+func (r IRect) Area() int64 {
+	if r.Empty() {
+		return 0
+	}
+	return int64(r.Dx()) * int64(r.Dy())
+}
+
+// Returns true if the rectangle contains no cells.
+func (r IRect) Empty() bool { return r.Min.X >= r.Max.X || r.Min.Y >= r.Max.Y }
+
+// Returns true if the cell p is inside the rectangle.
+func (r IRect) Contains(p IVect) bool {
+	return r.Min.X <= p.X && p.X < r.Max.X && r.Min.Y <= p.Y && p.Y < r.Max.Y
+}
+
+// Returns true if every cell of s is inside r. An empty s is inside any rectangle.
+func (r IRect) ContainsRect(s IRect) bool {
+	if s.Empty() {
+		return true
+	}
+	return r.Min.X <= s.Min.X && s.Max.X <= r.Max.X && r.Min.Y <= s.Min.Y && s.Max.Y <= r.Max.Y
+}
+
+// Returns true if the rectangles share at least one cell.
+func (r IRect) Overlaps(s IRect) bool {
+	return !r.Empty() && !s.Empty() &&
+		r.Min.X < s.Max.X && s.Min.X < r.Max.X && r.Min.Y < s.Max.Y && s.Min.Y < r.Max.Y
+}
+
+// Returns the cells in both rectangles, the zero IRect if there are none.
+func (r IRect) Intersect(s IRect) IRect {
+	r.Min = IVect{max32(r.Min.X, s.Min.X), max32(r.Min.Y, s.Min.Y)}
+	r.Max = IVect{min32(r.Max.X, s.Max.X), min32(r.Max.Y, s.Max.Y)}
+	if r.Empty() {
+		return IRect{}
+	}
+	return r
+}
+
+// Returns the smallest rectangle holding both rectangles. Empty rectangles are ignored.
+func (r IRect) Union(s IRect) IRect {
+	if r.Empty() {
+		return s
+	}
+	if s.Empty() {
+		return r
+	}
+	r.Min = IVect{min32(r.Min.X, s.Min.X), min32(r.Min.Y, s.Min.Y)}
+	r.Max = IVect{max32(r.Max.X, s.Max.X), max32(r.Max.Y, s.Max.Y)}
+	return r
+}
+
+// Returns the rectangle translated by p.
+func (r IRect) Offset(p IVect) IRect { return IRect{Add(r.Min, p), Add(r.Max, p)} }
+
+// Returns the rectangle shrunk by n cells on every side, or grown if n is negative.
+// Collapses to its center if it would become negative.
+func (r IRect) Inset(n int32) IRect {
+	if r.Dx() < 2*n {
+		r.Min.X = (r.Min.X + r.Max.X) / 2
+		r.Max.X = r.Min.X
+	} else {
+		r.Min.X += n
+		r.Max.X -= n
+	}
+	if r.Dy() < 2*n {
+		r.Min.Y = (r.Min.Y + r.Max.Y) / 2
+		r.Max.Y = r.Min.Y
+	} else {
+		r.Min.Y += n
+		r.Max.Y -= n
+	}
+	return r
+}
+
+// Calls fn for every cell, row by row from Min, until it returns false.
+func (r IRect) Each(fn func(p IVect) bool) {
+	for y := r.Min.Y; y < r.Max.Y; y++ {
+		for x := r.Min.X; x < r.Max.X; x++ {
+			if !fn(IVect{x, y}) {
+				return
+			}
+		}
+	}
+}
+
+// Returns the area covered by the cells, with edges at Min and Max.
+func (r IRect) AABB() aabb.AABB {
+	return aabb.New(f.Float(r.Min.X), f.Float(r.Min.Y), f.Float(r.Max.X), f.Float(r.Max.Y))
+}
+
+// Returns the box spanned by the cell coordinates, with edges at Min and Max-1.
+func (r IRect) AABBInclusive() aabb.AABB {
+	return aabb.New(f.Float(r.Min.X), f.Float(r.Min.Y), f.Float(r.Max.X-1), f.Float(r.Max.Y-1))
+}
+
+// Returns the smallest rectangle whose cells cover bb, the inverse of AABB.
+func RectFromAABB(bb aabb.AABB) IRect {
+	return IRect{Floor(v.V(bb.L, bb.B)), Ceil(v.V(bb.R, bb.T))}
+}
+
+// Returns the rectangle of the integer coordinates inside bb, the inverse of AABBInclusive.
+func RectFromAABBInclusive(bb aabb.AABB) IRect {
+	return IRect{Ceil(v.V(bb.L, bb.B)), Add(Floor(v.V(bb.R, bb.T)), IVect{1, 1})}
+}
+
+// Returns the rectangle as an image.Rectangle.
+func (r IRect) Rectangle() image.Rectangle {
+	return image.Rectangle{r.Min.Point(), r.Max.Point()}
+}
+
+// Converts an image.Rectangle, the coordinates are truncated to int32.
+func FromRectangle(r image.Rectangle) IRect {
+	return IRect{FromPoint(r.Min), FromPoint(r.Max)}
+}
@@ -0,0 +1,100 @@
+// Integer vectors and rectangles for tile maps and pixel coordinates.
+package grid
+
+import "image"
+import "github.com/oniproject/math/f"
+import "github.com/oniproject/math/v"
+
+// 2D vector with int32 components, a cell of a grid or a pixel.
+type IVect struct{ X, Y int32 }
+
+// Convenience constructor for IVect structs.
+func IV(x, y int32) IVect { return IVect{x, y} }
+
+// Unit steps to the 4 edge neighbors, counterclockwise from +X.
+var Dirs4 = [4]IVect{{1, 0}, {0, 1}, {-1, 0}, {0, -1}}
+
+// Unit steps to the 8 edge and corner neighbors, counterclockwise from +X.
+var Dirs8 = [8]IVect{{1, 0}, {1, 1}, {0, 1}, {-1, 1}, {-1, 0}, {-1, -1}, {0, -1}, {1, -1}}
+
+// Add two vectors.
+func Add(a, b IVect) IVect { return IVect{a.X + b.X, a.Y + b.Y} }
+
+// Subtract two vectors.
+func Sub(a, b IVect) IVect { return IVect{a.X - b.X, a.Y - b.Y} }
+
+// Negate a vector.
+func Neg(a IVect) IVect { return IVect{-a.X, -a.Y} }
+
+// Scalar multiplication.
+func Mult(a IVect, s int32) IVect { return IVect{a.X * s, a.Y * s} }
+
+// Vector dot product.
+func Dot(a, b IVect) int32 { return a.X*b.X + a.Y*b.Y }
+
+// 2D vector cross product analog, the z component of the 3D cross product.
+func Cross(a, b IVect) int32 { return a.X*b.Y - a.Y*b.X }
+
+// Returns the Manhattan (taxicab) distance, the number of 4-neighbor steps between a and b.
+func Manhattan(a, b IVect) int32 { return abs32(a.X-b.X) + abs32(a.Y-b.Y) }
+
+// Returns the Chebyshev distance, the number of 8-neighbor steps between a and b.
+func Chebyshev(a, b IVect) int32 { return max32(abs32(a.X-b.X), abs32(a.Y-b.Y)) }
+
+// Returns the 4 edge neighbors of p, in the order of Dirs4.
+func Neighbors4(p IVect) (n [4]IVect) {
+	for i, d := range Dirs4 {
+		n[i] = Add(p, d)
+	}
+	return
+}
+
+// Returns the 8 edge and corner neighbors of p, in the order of Dirs8.
+func Neighbors8(p IVect) (n [8]IVect) {
+	for i, d := range Dirs8 {
+		n[i] = Add(p, d)
+	}
+	return
+}
+
+// Returns the cell containing p, rounding both components down.
+func Floor(p v.Vect) IVect { return IVect{int32(f.Floor(p.X)), int32(f.Floor(p.Y))} }
+
+// Rounds both components of p to the nearest integer, halves away from zero.
+func Round(p v.Vect) IVect { return IVect{int32(f.Round(p.X)), int32(f.Round(p.Y))} }
+
+// Rounds both components of p up.
+func Ceil(p v.Vect) IVect { return IVect{int32(f.Ceil(p.X)), int32(f.Ceil(p.Y))} }
+
+// Returns the vector as a v.Vect.
+func (p IVect) Vect() v.Vect { return v.V(f.Float(p.X), f.Float(p.Y)) }
+
+// Returns the center of the cell p as a v.Vect.
+func (p IVect) Center() v.Vect { return v.V(f.Float(p.X)+0.5, f.Float(p.Y)+0.5) }
+
+// Returns the vector as an image.Point.
+func (p IVect) Point() image.Point { return image.Point{int(p.X), int(p.Y)} }
+
+// Converts an image.Point, the components are truncated to int32.
+func FromPoint(p image.Point) IVect { return IVect{int32(p.X), int32(p.Y)} }
+
+func abs32(x int32) int32 {
+	if x < 0 {
+		return -x
+	}
+	return x
+}
+
+func max32(a, b int32) int32 {
+	if a > b {
+		return a
+	}
+	return b
+}
+
+func min32(a, b int32) int32 {
+	if a < b {
+		return a
+	}
+	return b
+}
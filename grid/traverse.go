@@ -0,0 +1,110 @@
+package grid
+
+import "github.com/oniproject/math/f"
+import "github.com/oniproject/math/v"
+
+// Visits every cell of a grid with square cells of size cellSize that the
+// segment from a to b passes through, in order from a (Amanatides-Woo).
+// fn gets the cell and the fractions along the segment where it enters and
+// leaves it, in [0, 1] like aabb.SegmentQuery; returning false stops the traversal.
+// A segment passing exactly through a corner steps diagonally.
+func Traverse(a, b v.Vect, cellSize f.Float, fn func(cell IVect, enter, exit f.Float) bool) {
+	traverse(a, b, cellSize, false, fn)
+}
+
+// Like Traverse, but a segment passing exactly through a corner also visits
+// the two cells beside it, with enter == exit, so consecutive cells always
+// share an edge. Use it for line of sight that must not slip between corners.
+func TraverseSupercover(a, b v.Vect, cellSize f.Float, fn func(cell IVect, enter, exit f.Float) bool) {
+	traverse(a, b, cellSize, true, fn)
+}
+
+// Parameters of the traversal along one axis.
+// next is the fraction where the next cell boundary is crossed,
+// delta the fraction between two boundaries.
+type axis struct {
+	step        int32
+	next, delta f.Float
+}
+
+func newAxis(a, d, cell, size f.Float) axis {
+	switch {
+	case d > 0:
+		return axis{1, ((cell+1)*size - a) / d, size / d}
+	case d < 0:
+		return axis{-1, (cell*size - a) / d, -size / d}
+	}
+	return axis{0, f.Inf, f.Inf}
+}
+
+func traverse(a, b v.Vect, size f.Float, supercover bool, fn func(IVect, f.Float, f.Float) bool) {
+	cell := Floor(v.Mult(a, 1/size))
+	end := Floor(v.Mult(b, 1/size))
+	d := v.Sub(b, a)
+	x := newAxis(a.X, d.X, f.Float(cell.X), size)
+	y := newAxis(a.Y, d.Y, f.Float(cell.Y), size)
+
+	var enter f.Float
+	for cell != end {
+		// Rounding may reach a boundary late, the cell counts decide when an axis is done.
+		stepX := cell.X != end.X && (cell.Y == end.Y || x.next <= y.next)
+		stepY := cell.Y != end.Y && (cell.X == end.X || y.next <= x.next)
+
+		var t f.Float
+		if stepX {
+			t = x.next
+		} else {
+			t = y.next
+		}
+		t = f.Clamp(t, enter, 1)
+		if !fn(cell, enter, t) {
+			return
+		}
+
+		if stepX && stepY && supercover {
+			if !fn(IVect{cell.X + x.step, cell.Y}, t, t) || !fn(IVect{cell.X, cell.Y + y.step}, t, t) {
+				return
+			}
+		}
+		if stepX {
+			cell.X += x.step
+			x.next += x.delta
+		}
+		if stepY {
+			cell.Y += y.step
+			y.next += y.delta
+		}
+		enter = t
+	}
+	fn(cell, enter, 1)
+}
+
+// Visits the pixels of the line from a to b, both included (Bresenham).
+// Consecutive pixels share an edge or a corner.
+// Returning false from fn stops the traversal.
+func Bresenham(a, b IVect, fn func(p IVect) bool) {
+	dx, dy := abs32(b.X-a.X), -abs32(b.Y-a.Y)
+	sx, sy := int32(1), int32(1)
+	if a.X > b.X {
+		sx = -1
+	}
+	if a.Y > b.Y {
+		sy = -1
+	}
+
+	err := dx + dy
+	for {
+		if !fn(a) || a == b {
+			return
+		}
+		e2 := 2 * err
+		if e2 >= dy {
+			err += dy
+			a.X += sx
+		}
+		if e2 <= dx {
+			err += dx
+			a.Y += sy
+		}
+	}
+}
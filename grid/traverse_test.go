@@ -0,0 +1,142 @@
+package grid
+
+import (
+	"github.com/oniproject/math/aabb"
+	"github.com/oniproject/math/f"
+	"github.com/oniproject/math/v"
+	. "github.com/smartystreets/goconvey/convey"
+	"math/rand"
+	"testing"
+)
+
+type visit struct {
+	cell        IVect
+	enter, exit f.Float
+}
+
+func collect(a, b v.Vect, size f.Float, supercover bool) (visits []visit) {
+	fn := func(cell IVect, enter, exit f.Float) bool {
+		visits = append(visits, visit{cell, enter, exit})
+		return true
+	}
+	if supercover {
+		TraverseSupercover(a, b, size, fn)
+	} else {
+		Traverse(a, b, size, fn)
+	}
+	return
+}
+
+func cells(visits []visit) (list []IVect) {
+	for _, vi := range visits {
+		list = append(list, vi.cell)
+	}
+	return
+}
+
+func TestTraverse(test *testing.T) {
+	Convey("Grid traversal", test, func() {
+		Convey("Single cell", func() {
+			So(collect(v.V(0.2, 0.2), v.V(0.8, 0.7), 1, false), ShouldResemble, []visit{{IV(0, 0), 0, 1}})
+			So(collect(v.V(0.5, 0.5), v.V(0.5, 0.5), 1, false), ShouldResemble, []visit{{IV(0, 0), 0, 1}})
+		})
+		Convey("Horizontal", func() {
+			visits := collect(v.V(0.5, 0.5), v.V(3.5, 0.5), 1, false)
+			So(cells(visits), ShouldResemble, []IVect{IV(0, 0), IV(1, 0), IV(2, 0), IV(3, 0)})
+			So(visits[1].enter, ShouldAlmostEqual, 1.0/6, 1e-6)
+			So(visits[1].exit, ShouldAlmostEqual, 3.0/6, 1e-6)
+			So(visits[3].exit, ShouldEqual, 1)
+		})
+		Convey("Negative direction and cell size", func() {
+			visits := collect(v.V(-0.5, 5), v.V(-9, 5), 4, false)
+			So(cells(visits), ShouldResemble, []IVect{IV(-1, 1), IV(-2, 1), IV(-3, 1)})
+		})
+		Convey("Through a corner", func() {
+			a, b := v.V(0.5, 0.5), v.V(2.5, 2.5)
+			So(cells(collect(a, b, 1, false)), ShouldResemble, []IVect{IV(0, 0), IV(1, 1), IV(2, 2)})
+
+			visits := collect(a, b, 1, true)
+			So(cells(visits), ShouldResemble, []IVect{
+				IV(0, 0), IV(1, 0), IV(0, 1), IV(1, 1), IV(2, 1), IV(1, 2), IV(2, 2),
+			})
+			So(visits[1].enter, ShouldEqual, visits[1].exit)
+			So(visits[1].enter, ShouldAlmostEqual, 0.25, 1e-6)
+		})
+		Convey("Stop early", func() {
+			n := 0
+			Traverse(v.V(0, 0), v.V(10, 0.5), 1, func(cell IVect, enter, exit f.Float) bool {
+				n++
+				return n < 3
+			})
+			So(n, ShouldEqual, 3)
+		})
+		Convey("Matches SegmentQuery", func() {
+			rng := rand.New(rand.NewSource(3))
+			rnd := func() f.Float { return f.Float(rng.Float64()*20 - 10) }
+			for i := 0; i < 200; i++ {
+				a, b := v.V(rnd(), rnd()), v.V(rnd(), rnd())
+				const size = 1.5
+				visits := collect(a, b, size, i%2 == 0)
+
+				So(visits[0].cell, ShouldResemble, Floor(v.Mult(a, 1/size)))
+				So(visits[len(visits)-1].cell, ShouldResemble, Floor(v.Mult(b, 1/size)))
+				So(visits[len(visits)-1].exit, ShouldEqual, 1)
+				for j, vi := range visits {
+					So(vi.enter, ShouldBeLessThanOrEqualTo, vi.exit)
+					if j > 0 {
+						So(vi.enter, ShouldEqual, visits[j-1].exit)
+						So(Chebyshev(vi.cell, visits[j-1].cell), ShouldEqual, 1)
+						bb := aabb.New(
+							f.Float(vi.cell.X)*size, f.Float(vi.cell.Y)*size,
+							f.Float(vi.cell.X+1)*size, f.Float(vi.cell.Y+1)*size)
+						So(bb.SegmentQuery(a, b), ShouldAlmostEqual, vi.enter, 1e-4)
+					}
+				}
+			}
+		})
+		Convey("Supercover is 4-connected", func() {
+			for _, b := range []v.Vect{v.V(4, 4), v.V(-3, 3), v.V(5, -5), v.V(3.3, 7.1)} {
+				visits := collect(v.V(0.5, 0.5), v.Add(b, v.V(0.5, 0.5)), 1, true)
+				for j := 1; j < len(visits); j++ {
+					// Side cells touch the previous cell by an edge or the corner cell before it.
+					d := Manhattan(visits[j].cell, visits[j-1].cell)
+					So(d == 1 || (d == 2 && visits[j-1].enter == visits[j-1].exit), ShouldBeTrue)
+				}
+			}
+		})
+	})
+}
+
+func TestBresenham(test *testing.T) {
+	Convey("Bresenham", test, func() {
+		line := func(a, b IVect) (list []IVect) {
+			Bresenham(a, b, func(p IVect) bool {
+				list = append(list, p)
+				return true
+			})
+			return
+		}
+		So(line(IV(0, 0), IV(0, 0)), ShouldResemble, []IVect{IV(0, 0)})
+		So(line(IV(0, 0), IV(3, 0)), ShouldResemble, []IVect{IV(0, 0), IV(1, 0), IV(2, 0), IV(3, 0)})
+		// Ties at half pixels round up.
+		So(line(IV(0, 0), IV(4, 2)), ShouldResemble, []IVect{IV(0, 0), IV(1, 1), IV(2, 1), IV(3, 2), IV(4, 2)})
+		So(line(IV(0, 0), IV(-2, -2)), ShouldResemble, []IVect{IV(0, 0), IV(-1, -1), IV(-2, -2)})
+
+		for _, b := range []IVect{IV(7, 3), IV(-3, 8), IV(-9, -2), IV(4, -11)} {
+			list := line(IV(1, 1), b)
+			So(list[0], ShouldResemble, IV(1, 1))
+			So(list[len(list)-1], ShouldResemble, b)
+			So(len(list), ShouldEqual, Chebyshev(IV(1, 1), b)+1)
+			for j := 1; j < len(list); j++ {
+				So(Chebyshev(list[j], list[j-1]), ShouldEqual, 1)
+			}
+		}
+
+		n := 0
+		Bresenham(IV(0, 0), IV(10, 0), func(p IVect) bool {
+			n++
+			return n < 2
+		})
+		So(n, ShouldEqual, 2)
+	})
+}
@@ -0,0 +1,41 @@
+package img
+
+import xf32 "golang.org/x/image/math/f32"
+import xf64 "golang.org/x/image/math/f64"
+import "github.com/oniproject/math/f"
+import "github.com/oniproject/math/t"
+
+// Aff3 is row major: x' = m[0]*x + m[1]*y + m[2], y' = m[3]*x + m[4]*y + m[5],
+// the same order as t.Transpose.
+
+// Returns the transform as an f32.Aff3.
+func Aff3(m t.Transform) xf32.Aff3 {
+	return xf32.Aff3{
+		float32(m.A), float32(m.C), float32(m.Tx),
+		float32(m.B), float32(m.D), float32(m.Ty),
+	}
+}
+
+// Returns the transform as an f64.Aff3.
+func Aff3F64(m t.Transform) xf64.Aff3 {
+	return xf64.Aff3{
+		float64(m.A), float64(m.C), float64(m.Tx),
+		float64(m.B), float64(m.D), float64(m.Ty),
+	}
+}
+
+// Converts an f32.Aff3 to a transform.
+func FromAff3(m xf32.Aff3) t.Transform {
+	return t.Transpose(
+		f.Float(m[0]), f.Float(m[1]), f.Float(m[2]),
+		f.Float(m[3]), f.Float(m[4]), f.Float(m[5]),
+	)
+}
+
+// Converts an f64.Aff3 to a transform.
+func FromAff3F64(m xf64.Aff3) t.Transform {
+	return t.Transpose(
+		f.Float(m[0]), f.Float(m[1]), f.Float(m[2]),
+		f.Float(m[3]), f.Float(m[4]), f.Float(m[5]),
+	)
+}
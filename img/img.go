@@ -0,0 +1,87 @@
+// Conversions between the math types and the standard image libraries:
+// image.Point, image.Rectangle, fixed.Point26_6 and the f32.Aff3 and
+// f64.Aff3 matrices used by golang.org/x/image/draw.
+//
+// Images have Y pointing down while AABB is (left, bottom, right, top).
+// A Space tells whether Y points up in math coordinates and needs flipping.
+package img
+
+import "image"
+import xfixed "golang.org/x/image/math/fixed"
+import "github.com/oniproject/math/f"
+import "github.com/oniproject/math/v"
+import "github.com/oniproject/math/aabb"
+import "github.com/oniproject/math/t"
+
+// Mapping between math coordinates and image coordinates.
+type Space struct {
+	// Y points up in math coordinates, so y maps to Height - y in the image.
+	FlipY  bool
+	Height f.Float
+}
+
+// Math coordinates match image coordinates.
+var YDown = Space{}
+
+// Math coordinates with Y pointing up over an image of the given height.
+func YUp(height f.Float) Space { return Space{true, height} }
+
+func (s Space) y(y f.Float) f.Float {
+	if s.FlipY {
+		return s.Height - y
+	}
+	return y
+}
+
+// Returns the transform from math coordinates to image coordinates.
+func (s Space) Transform() t.Transform {
+	if s.FlipY {
+		return t.New(1, 0, 0, -1, 0, s.Height)
+	}
+	return t.Identity()
+}
+
+// Returns the pixel containing p.
+func (s Space) Point(p v.Vect) image.Point {
+	return image.Point{int(f.Floor(p.X)), int(f.Floor(s.y(p.Y)))}
+}
+
+// Returns the position of the image point p.
+func (s Space) Vect(p image.Point) v.Vect {
+	return v.V(f.Float(p.X), s.y(f.Float(p.Y)))
+}
+
+// Returns p rounded to the nearest 1/64 of a pixel.
+func (s Space) Point26_6(p v.Vect) xfixed.Point26_6 {
+	return xfixed.Point26_6{X: to26_6(p.X), Y: to26_6(s.y(p.Y))}
+}
+
+// Returns the position of the fixed point p.
+func (s Space) Vect26_6(p xfixed.Point26_6) v.Vect {
+	return v.V(from26_6(p.X), s.y(from26_6(p.Y)))
+}
+
+// Returns the smallest rectangle of pixels covering bb.
+func (s Space) Rectangle(bb aabb.AABB) image.Rectangle {
+	y0, y1 := s.y(bb.B), s.y(bb.T)
+	if s.FlipY {
+		y0, y1 = y1, y0
+	}
+	return image.Rectangle{
+		image.Point{int(f.Floor(bb.L)), int(f.Floor(y0))},
+		image.Point{int(f.Ceil(bb.R)), int(f.Ceil(y1))},
+	}
+}
+
+// Returns the area covered by the pixels of r.
+func (s Space) AABB(r image.Rectangle) aabb.AABB {
+	b, top := s.y(f.Float(r.Min.Y)), s.y(f.Float(r.Max.Y))
+	if s.FlipY {
+		b, top = top, b
+	}
+	return aabb.New(f.Float(r.Min.X), b, f.Float(r.Max.X), top)
+}
+
+func to26_6(x f.Float) xfixed.Int26_6 { return xfixed.Int26_6(f.Round(x * 64)) }
+
+func from26_6(x xfixed.Int26_6) f.Float { return f.Float(x) / 64 }
@@ -0,0 +1,77 @@
+package img
+
+import (
+	"github.com/oniproject/math/aabb"
+	"github.com/oniproject/math/t"
+	"github.com/oniproject/math/v"
+	. "github.com/smartystreets/goconvey/convey"
+	xdraw "golang.org/x/image/draw"
+	xf32 "golang.org/x/image/math/f32"
+	xf64 "golang.org/x/image/math/f64"
+	xfixed "golang.org/x/image/math/fixed"
+	"image"
+	"image/color"
+	"image/draw"
+	"testing"
+)
+
+func TestSpace(test *testing.T) {
+	Convey("Y down", test, func() {
+		s := YDown
+		So(s.Point(v.V(1.5, -0.5)), ShouldResemble, image.Pt(1, -1))
+		So(s.Vect(image.Pt(3, 4)), ShouldResemble, v.V(3, 4))
+		So(s.Point(s.Vect(image.Pt(3, 4))), ShouldResemble, image.Pt(3, 4))
+
+		So(s.Point26_6(v.V(1.5, 0.25)), ShouldResemble, xfixed.Point26_6{X: 96, Y: 16})
+		So(s.Vect26_6(xfixed.P(2, 3)), ShouldResemble, v.V(2, 3))
+
+		bb := aabb.New(0.5, 1, 3.2, 4)
+		So(s.Rectangle(bb), ShouldResemble, image.Rect(0, 1, 4, 4))
+		So(s.AABB(image.Rect(0, 1, 4, 4)), ShouldResemble, aabb.New(0, 1, 4, 4))
+		So(s.Transform(), ShouldResemble, t.Identity())
+	})
+	Convey("Y up", test, func() {
+		s := YUp(10)
+		// The bottom left corner of the math space is the bottom left of the image.
+		So(s.Point(v.V(0.5, 0.5)), ShouldResemble, image.Pt(0, 9))
+		So(s.Vect(image.Pt(0, 10)), ShouldResemble, v.V(0, 0))
+		So(s.Point(s.Vect(image.Pt(3, 4))), ShouldResemble, image.Pt(3, 4))
+		So(s.Point26_6(v.V(0, 9.5)), ShouldResemble, xfixed.Point26_6{X: 0, Y: 32})
+		So(s.Vect26_6(s.Point26_6(v.V(1.25, 2.5))), ShouldResemble, v.V(1.25, 2.5))
+
+		bb := aabb.New(1, 1, 3, 2.5)
+		r := s.Rectangle(bb)
+		So(r, ShouldResemble, image.Rect(1, 7, 3, 9))
+		So(s.AABB(r), ShouldResemble, aabb.New(1, 1, 3, 3))
+
+		m := s.Transform()
+		So(m.Point(v.V(2, 3)), ShouldResemble, v.V(2, 7))
+	})
+}
+
+func TestAff3(test *testing.T) {
+	Convey("Aff3", test, func() {
+		m := t.New(1, 2, 3, 4, 5, 6)
+		So(Aff3(m), ShouldResemble, xf32.Aff3{1, 3, 5, 2, 4, 6})
+		So(Aff3F64(m), ShouldResemble, xf64.Aff3{1, 3, 5, 2, 4, 6})
+		So(FromAff3(Aff3(m)), ShouldResemble, m)
+		So(FromAff3F64(Aff3F64(m)), ShouldResemble, m)
+
+		Convey("With x/image/draw", func() {
+			src := image.NewRGBA(image.Rect(0, 0, 1, 1))
+			src.Set(0, 0, color.White)
+			dst := image.NewRGBA(image.Rect(0, 0, 10, 10))
+
+			// Place the pixel at (2, 3) in a Y-up space of the image.
+			s := YUp(10)
+			m := t.Mult(s.Transform(), t.Translate(v.V(2, 3)))
+			// Flipping maps the pixel onto [2, 3] x [6, 7].
+			m = t.Mult(m, t.New(1, 0, 0, -1, 0, 1))
+			xdraw.NearestNeighbor.Transform(dst, Aff3F64(m), src, src.Bounds(), draw.Over, nil)
+
+			So(dst.At(2, 6), ShouldResemble, color.RGBA{255, 255, 255, 255})
+			So(dst.At(2, 3), ShouldResemble, color.RGBA{})
+			So(s.Point(v.V(2.5, 3.5)), ShouldResemble, image.Pt(2, 6))
+		})
+	})
+}
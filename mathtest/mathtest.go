@@ -0,0 +1,132 @@
+// Goconvey style assertions for comparing Floats, vectors, bounding boxes
+// and transforms with a tolerance.
+//
+//	So(t.Inverse(m), mathtest.ShouldApproxEqual, want)
+//	So(p, mathtest.ShouldApproxEqual, want, 1e-3)
+//	So(x, mathtest.ShouldBeWithinULPs, want, 4)
+//
+// Any float or struct made only of floats is accepted, so the generic
+// types work as well. A failure lists every differing field.
+package mathtest
+
+import "fmt"
+import "reflect"
+import "strconv"
+import "strings"
+import "github.com/oniproject/math/generic/f"
+
+// Default tolerance of ShouldApproxEqual, used as both the absolute and the relative epsilon.
+var Tolerance = 1e-5
+
+// Passes if actual equals expected[0] within a tolerance per component.
+// The tolerance is expected[1] if given or Tolerance otherwise,
+// see f.ApproxEqual.
+func ShouldApproxEqual(actual interface{}, expected ...interface{}) string {
+	if len(expected) < 1 || len(expected) > 2 {
+		return "This assertion requires an expected value and an optional tolerance."
+	}
+	eps := Tolerance
+	if len(expected) == 2 {
+		var ok bool
+		if eps, ok = toFloat(expected[1]); !ok {
+			return fmt.Sprintf("The tolerance must be a number, not %T.", expected[1])
+		}
+	}
+	return compare(actual, expected[0], fmt.Sprintf("tolerance %g", eps), func(a, b field) bool {
+		return f.ApproxEqual(a.value, b.value, eps, eps)
+	})
+}
+
+// Passes if every component of actual is at most expected[1] ULPs from expected[0].
+func ShouldBeWithinULPs(actual interface{}, expected ...interface{}) string {
+	if len(expected) != 2 {
+		return "This assertion requires an expected value and a number of ULPs."
+	}
+	n, ok := toFloat(expected[1])
+	if !ok || n < 0 {
+		return fmt.Sprintf("The number of ULPs must be a non-negative number, not %v.", expected[1])
+	}
+	return compare(actual, expected[0], fmt.Sprintf("within %d ULPs", uint64(n)), func(a, b field) bool {
+		if a.bits == 32 {
+			return f.WithinULPs(float32(a.value), float32(b.value), uint64(n))
+		}
+		return f.WithinULPs(a.value, b.value, uint64(n))
+	})
+}
+
+// A float component of a value.
+type field struct {
+	name  string
+	value float64
+	bits  int
+}
+
+// Split x into its float components.
+func fields(x interface{}) ([]field, bool) {
+	rv := reflect.ValueOf(x)
+	switch rv.Kind() {
+	case reflect.Float32:
+		return []field{{"", rv.Float(), 32}}, true
+	case reflect.Float64:
+		return []field{{"", rv.Float(), 64}}, true
+	case reflect.Struct:
+		list := make([]field, rv.NumField())
+		for i := range list {
+			fv := rv.Field(i)
+			switch fv.Kind() {
+			case reflect.Float32:
+				list[i] = field{rv.Type().Field(i).Name, fv.Float(), 32}
+			case reflect.Float64:
+				list[i] = field{rv.Type().Field(i).Name, fv.Float(), 64}
+			default:
+				return nil, false
+			}
+		}
+		return list, true
+	}
+	return nil, false
+}
+
+func toFloat(x interface{}) (float64, bool) {
+	rv := reflect.ValueOf(x)
+	switch rv.Kind() {
+	case reflect.Float32, reflect.Float64:
+		return rv.Float(), true
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		return float64(rv.Int()), true
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64:
+		return float64(rv.Uint()), true
+	}
+	return 0, false
+}
+
+// Compare actual and expected component wise and describe the differences.
+func compare(actual, expected interface{}, how string, eq func(a, b field) bool) string {
+	if reflect.TypeOf(actual) != reflect.TypeOf(expected) {
+		return fmt.Sprintf("Expected a %T but got a %T!", expected, actual)
+	}
+	a, ok := fields(actual)
+	if !ok {
+		return fmt.Sprintf("Cannot compare a %T, it must be a float or a struct of floats.", actual)
+	}
+	e, _ := fields(expected)
+
+	var diff []string
+	for i := range a {
+		if !eq(a[i], e[i]) {
+			name := a[i].name
+			if name == "" {
+				name = "value"
+			}
+			diff = append(diff, fmt.Sprintf("  %s: %s != %s (delta %g)", name,
+				strconv.FormatFloat(a[i].value, 'g', -1, a[i].bits),
+				strconv.FormatFloat(e[i].value, 'g', -1, e[i].bits),
+				a[i].value-e[i].value))
+		}
+	}
+	if len(diff) == 0 {
+		return ""
+	}
+	return fmt.Sprintf("Expected: %+v\nActual:   %+v\nDiffers (%s):\n%s",
+		expected, actual, how, strings.Join(diff, "\n"))
+}
@@ -0,0 +1,49 @@
+package mathtest
+
+import (
+	"github.com/oniproject/math/aabb"
+	"github.com/oniproject/math/f"
+	"github.com/oniproject/math/t"
+	"github.com/oniproject/math/v"
+	. "github.com/smartystreets/goconvey/convey"
+	"strings"
+	"testing"
+)
+
+func TestAssertions(test *testing.T) {
+	Convey("Assertions", test, func() {
+		Convey("ShouldApproxEqual", func() {
+			So(ShouldApproxEqual(f.Float(1), f.Float(1+1e-6)), ShouldEqual, "")
+			So(ShouldApproxEqual(f.Float(1), f.Float(1.1)), ShouldNotEqual, "")
+			So(ShouldApproxEqual(f.Float(1), f.Float(1.1), 0.2), ShouldEqual, "")
+			So(ShouldApproxEqual(1e6, 1e6+1), ShouldEqual, "")
+
+			m := t.Mult(t.Rotate(0.5), t.Rotate(-0.5))
+			So(m, ShouldApproxEqual, t.Identity())
+			So(aabb.New(0, 0, 1, 1), ShouldApproxEqual, aabb.New(0, 0, 1, 1+1e-7))
+		})
+		Convey("Diff", func() {
+			msg := ShouldApproxEqual(v.V(1, 2.5), v.V(1, 2))
+			So(msg, ShouldContainSubstring, "Y: 2.5 != 2 (delta 0.5)")
+			So(msg, ShouldNotContainSubstring, "  X:")
+			So(msg, ShouldContainSubstring, "tolerance 1e-05")
+			So(strings.Count(msg, "\n"), ShouldEqual, 3)
+		})
+		Convey("ShouldBeWithinULPs", func() {
+			a := f.Float(1)
+			b := f.NextAfter(f.NextAfter(a, 2), 2)
+			So(ShouldBeWithinULPs(a, b, 2), ShouldEqual, "")
+			msg := ShouldBeWithinULPs(a, b, 1)
+			So(msg, ShouldContainSubstring, "within 1 ULPs")
+			So(ShouldBeWithinULPs(float32(1), float32(1.0000001), 1), ShouldEqual, "")
+			So(ShouldBeWithinULPs(v.V(1, 2), v.V(1, 2), 0), ShouldEqual, "")
+		})
+		Convey("Misuse", func() {
+			So(ShouldApproxEqual(f.Float(1)), ShouldNotEqual, "")
+			So(ShouldApproxEqual(f.Float(1), f.Float(1), "x"), ShouldContainSubstring, "tolerance")
+			So(ShouldApproxEqual(f.Float(1), v.V(1, 1)), ShouldContainSubstring, "Expected a")
+			So(ShouldApproxEqual("a", "a"), ShouldContainSubstring, "Cannot compare")
+			So(ShouldBeWithinULPs(f.Float(1), f.Float(1)), ShouldNotEqual, "")
+		})
+	})
+}
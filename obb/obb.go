@@ -0,0 +1,178 @@
+// Oriented 2D bounding box type complementing aabb.AABB.
+package obb
+
+import "github.com/oniproject/math/f"
+import "github.com/oniproject/math/v"
+import "github.com/oniproject/math/t"
+import "github.com/oniproject/math/aabb"
+import "github.com/oniproject/math/geom"
+
+// Box with half sizes HalfExtents around Center, rotated by the unit
+// rotation vector Rot as in v.Rotate.
+type OBB struct {
+	Center, HalfExtents v.Vect
+	Rot                 v.Vect
+}
+
+// Constructs an OBB centered on a point, rotated by the angle in radians.
+func New(center, halfExtents v.Vect, radians f.Float) OBB {
+	return OBB{center, halfExtents, v.ForAngle(radians)}
+}
+
+// Constructs an unrotated OBB covering the same area as bb.
+func ForAABB(bb aabb.AABB) OBB {
+	return OBB{bb.Center(), v.V((bb.R-bb.L)*0.5, (bb.T-bb.B)*0.5), v.V(1, 0)}
+}
+
+// Constructs the OBB of bb transformed by tr, aligned to the transformed x axis.
+// Exact for rotations, scales and translations, a skewed box is enclosed.
+func ForTransform(tr t.Transform, bb aabb.AABB) OBB {
+	rot := v.Normalize(tr.Vect(v.V(1, 0)))
+	if rot == (v.Vect{}) {
+		rot = v.V(1, 0)
+	}
+	corners := [4]v.Vect{
+		tr.Point(v.V(bb.L, bb.B)), tr.Point(v.V(bb.R, bb.B)),
+		tr.Point(v.V(bb.R, bb.T)), tr.Point(v.V(bb.L, bb.T)),
+	}
+	return enclose(corners[:], rot)
+}
+
+// Returns the smallest box with the orientation rot enclosing points.
+func enclose(points []v.Vect, rot v.Vect) OBB {
+	lo, hi := v.V(f.Inf, f.Inf), v.V(-f.Inf, -f.Inf)
+	for _, p := range points {
+		l := v.UnRotate(p, rot)
+		lo = v.V(f.Min(lo.X, l.X), f.Min(lo.Y, l.Y))
+		hi = v.V(f.Max(hi.X, l.X), f.Max(hi.Y, l.Y))
+	}
+	return OBB{
+		v.Rotate(v.Mult(v.Add(lo, hi), 0.5), rot),
+		v.Mult(v.Sub(hi, lo), 0.5),
+		rot,
+	}
+}
+
+// Constructs the minimum area OBB enclosing points using rotating calipers
+// over their convex hull. Returns false if there are no points.
+func ForPoints(points []v.Vect) (OBB, bool) {
+	hull := geom.ConvexHull(points)
+	switch len(hull) {
+	case 0:
+		return OBB{}, false
+	case 1:
+		return OBB{hull[0], v.Zero(), v.V(1, 0)}, true
+	case 2:
+		return enclose(hull, v.Normalize(v.Sub(hull[1], hull[0]))), true
+	}
+
+	n := len(hull)
+	at := func(i int) v.Vect { return hull[i%n] }
+	// One side of the box is flush with the edge i, the calipers r, u and l
+	// track the rightmost, uppermost and leftmost points relative to it.
+	r, u, l := 0, 0, 0
+	best, bestArea := OBB{}, f.Inf
+	for i := 0; i < n; i++ {
+		p := hull[i]
+		x := v.Normalize(v.Sub(at(i+1), p))
+		y := v.LPerp(x)
+		along := func(j int, axis v.Vect) f.Float { return v.Dot(v.Sub(at(j), p), axis) }
+
+		// Every caliper only moves forward and stays ahead of the previous one.
+		if r <= i {
+			r = i + 1
+		}
+		for along(r+1, x) > along(r, x) {
+			r++
+		}
+		if u < r {
+			u = r
+		}
+		for along(u+1, y) > along(u, y) {
+			u++
+		}
+		if l < u {
+			l = u
+		}
+		for along(l+1, x) < along(l, x) {
+			l++
+		}
+
+		minX, maxX, maxY := along(l, x), along(r, x), along(u, y)
+		if area := (maxX - minX) * maxY; area < bestArea {
+			bestArea = area
+			center := v.Add(p, v.Add(v.Mult(x, (minX+maxX)*0.5), v.Mult(y, maxY*0.5)))
+			best = OBB{center, v.V((maxX-minX)*0.5, maxY*0.5), x}
+		}
+	}
+	return best, true
+}
+
+// Returns the x and y axes of the box, both of unit length.
+func (o OBB) Axes() (x, y v.Vect) { return o.Rot, v.LPerp(o.Rot) }
+
+// Returns the corners of the box in counterclockwise order.
+func (o OBB) Corners() [4]v.Vect {
+	x, y := o.Axes()
+	ex, ey := v.Mult(x, o.HalfExtents.X), v.Mult(y, o.HalfExtents.Y)
+	return [4]v.Vect{
+		v.Sub(v.Sub(o.Center, ex), ey),
+		v.Sub(v.Add(o.Center, ex), ey),
+		v.Add(v.Add(o.Center, ex), ey),
+		v.Add(v.Sub(o.Center, ex), ey),
+	}
+}
+
+// Returns the transform from the box space, where the box spans
+// -HalfExtents to HalfExtents, to world space.
+func (o OBB) Transform() t.Transform {
+	x, y := o.Axes()
+	return t.New(x.X, x.Y, y.X, y.Y, o.Center.X, o.Center.Y)
+}
+
+// Returns the area of the box.
+func (o OBB) Area() f.Float { return 4 * o.HalfExtents.X * o.HalfExtents.Y }
+
+// Returns the tight AABB of the box.
+func (o OBB) AABB() aabb.AABB {
+	cx, sx := f.Abs(o.Rot.X), f.Abs(o.Rot.Y)
+	hw := o.HalfExtents.X*cx + o.HalfExtents.Y*sx
+	hh := o.HalfExtents.X*sx + o.HalfExtents.Y*cx
+	return aabb.ForExtents(o.Center, hw, hh)
+}
+
+// Returns true if p is inside the box or on its boundary.
+func (o OBB) ContainsVect(p v.Vect) bool {
+	l := v.UnRotate(v.Sub(p, o.Center), o.Rot)
+	return f.Abs(l.X) <= o.HalfExtents.X && f.Abs(l.Y) <= o.HalfExtents.Y
+}
+
+// Returns true if other lies completely inside the box.
+func (o OBB) Contains(other OBB) bool {
+	for _, c := range other.Corners() {
+		if !o.ContainsVect(c) {
+			return false
+		}
+	}
+	return true
+}
+
+// Half width of the projection of the box onto axis.
+func (o OBB) radius(axis v.Vect) f.Float {
+	x, y := o.Axes()
+	return o.HalfExtents.X*f.Abs(v.Dot(x, axis)) + o.HalfExtents.Y*f.Abs(v.Dot(y, axis))
+}
+
+// Returns true if the boxes overlap, touching counts.
+// Uses the separating axis test on the axes of both boxes.
+func Intersects(a, b OBB) bool {
+	d := v.Sub(b.Center, a.Center)
+	ax, ay := a.Axes()
+	bx, by := b.Axes()
+	for _, axis := range [4]v.Vect{ax, ay, bx, by} {
+		if f.Abs(v.Dot(d, axis)) > a.radius(axis)+b.radius(axis) {
+			return false
+		}
+	}
+	return true
+}
@@ -0,0 +1,147 @@
+package obb
+
+import (
+	"github.com/oniproject/math/aabb"
+	"github.com/oniproject/math/f"
+	"github.com/oniproject/math/geom"
+	"github.com/oniproject/math/mathtest"
+	"github.com/oniproject/math/t"
+	"github.com/oniproject/math/v"
+	. "github.com/smartystreets/goconvey/convey"
+	"math"
+	"math/rand"
+	"testing"
+)
+
+// Returns true if p is inside o grown by eps.
+func containsApprox(o OBB, p v.Vect, eps f.Float) bool {
+	o.HalfExtents = v.Add(o.HalfExtents, v.V(eps, eps))
+	return o.ContainsVect(p)
+}
+
+func TestOBB(test *testing.T) {
+	Convey("OBB", test, func() {
+		o := New(v.V(1, 2), v.V(2, 1), math.Pi/2)
+
+		Convey("Corners and AABB", func() {
+			c := o.Corners()
+			So(c[0], mathtest.ShouldApproxEqual, v.V(2, 0))
+			So(c[1], mathtest.ShouldApproxEqual, v.V(2, 4))
+			So(c[2], mathtest.ShouldApproxEqual, v.V(0, 4))
+			So(c[3], mathtest.ShouldApproxEqual, v.V(0, 0))
+			So(o.AABB(), mathtest.ShouldApproxEqual, aabb.New(0, 0, 2, 4))
+			So(o.Area(), ShouldEqual, 8)
+
+			tr := o.Transform()
+			So(tr.Point(v.V(-2, -1)), mathtest.ShouldApproxEqual, c[0])
+			So(tr.Point(v.V(2, 1)), mathtest.ShouldApproxEqual, c[2])
+
+			box := ForAABB(aabb.New(0, 0, 4, 2))
+			So(box, ShouldResemble, OBB{v.V(2, 1), v.V(2, 1), v.V(1, 0)})
+			So(box.AABB(), ShouldResemble, aabb.New(0, 0, 4, 2))
+		})
+		Convey("AABB is tight", func() {
+			long := New(v.V(0, 0), v.V(10, 0.5), math.Pi/6)
+			bb := long.AABB()
+			for _, c := range long.Corners() {
+				So(bb.ContainsVect(c), ShouldBeTrue)
+			}
+			hull := aabb.New(f.Inf, f.Inf, -f.Inf, -f.Inf)
+			for _, c := range long.Corners() {
+				hull = aabb.Expand(hull, c)
+			}
+			So(bb, mathtest.ShouldApproxEqual, hull)
+		})
+		Convey("ContainsVect and Contains", func() {
+			So(o.ContainsVect(v.V(1, 3.5)), ShouldBeTrue)
+			So(o.ContainsVect(v.V(2.5, 2)), ShouldBeFalse)
+			So(o.ContainsVect(v.V(1, 2)), ShouldBeTrue)
+
+			So(o.Contains(New(v.V(1, 2), v.V(1, 0.5), math.Pi/2)), ShouldBeTrue)
+			So(o.Contains(New(v.V(1, 2), v.V(1, 0.5), 0)), ShouldBeTrue)
+			So(o.Contains(New(v.V(1, 2), v.V(1.5, 0.5), 0)), ShouldBeFalse)
+			So(o.Contains(New(v.V(5, 2), v.V(0.1, 0.1), 0)), ShouldBeFalse)
+		})
+		Convey("Intersects", func() {
+			a := New(v.V(0, 0), v.V(2, 0.5), math.Pi/4)
+			So(Intersects(a, a), ShouldBeTrue)
+			So(Intersects(a, New(v.V(1, 1), v.V(0.5, 0.5), 0)), ShouldBeTrue)
+			// The AABBs overlap but the boxes do not.
+			b := New(v.V(1.2, -1.2), v.V(0.5, 0.5), 0)
+			So(aabb.Intersects(a.AABB(), b.AABB()), ShouldBeTrue)
+			So(Intersects(a, b), ShouldBeFalse)
+			So(Intersects(b, a), ShouldBeFalse)
+			// Separated only along an axis of b.
+			c := New(v.V(1.5, 1.5), v.V(1, 0.2), -math.Pi/4)
+			square := ForAABB(aabb.New(-1, -1, 1, 1))
+			So(aabb.Intersects(square.AABB(), c.AABB()), ShouldBeTrue)
+			So(Intersects(square, c), ShouldBeFalse)
+			So(Intersects(c, ForAABB(aabb.New(-1, -1, 1.5, 1.5))), ShouldBeTrue)
+		})
+		Convey("ForTransform", func() {
+			bb := aabb.New(-1, -2, 3, 2)
+			tr := t.Mult(t.Translate(v.V(5, 1)), t.Mult(t.Rotate(0.3), t.Scale(2, 0.5)))
+			box := ForTransform(tr, bb)
+			So(box.Area(), ShouldAlmostEqual, bb.Area()*2*0.5, 1e-4)
+			So(box.Center, mathtest.ShouldApproxEqual, tr.Point(bb.Center()))
+			So(box.AABB(), mathtest.ShouldApproxEqual, tr.BB(bb))
+			for _, c := range box.Corners() {
+				So(containsApprox(box, c, 1e-4), ShouldBeTrue)
+			}
+
+			skew := t.New(1, 0, 1, 1, 0, 0)
+			box = ForTransform(skew, bb)
+			for _, p := range []v.Vect{v.V(bb.L, bb.B), v.V(bb.R, bb.B), v.V(bb.R, bb.T), v.V(bb.L, bb.T)} {
+				So(containsApprox(box, skew.Point(p), 1e-4), ShouldBeTrue)
+			}
+		})
+		Convey("ForPoints", func() {
+			_, ok := ForPoints(nil)
+			So(ok, ShouldBeFalse)
+
+			box, ok := ForPoints([]v.Vect{v.V(3, 4)})
+			So(ok, ShouldBeTrue)
+			So(box, ShouldResemble, OBB{v.V(3, 4), v.Zero(), v.V(1, 0)})
+
+			box, _ = ForPoints([]v.Vect{v.V(0, 0), v.V(3, 4), v.V(6, 8)})
+			So(box.Center, mathtest.ShouldApproxEqual, v.V(3, 4))
+			So(box.HalfExtents, mathtest.ShouldApproxEqual, v.V(5, 0))
+
+			// Points of a rotated rectangle and its inside.
+			rect := New(v.V(2, -1), v.V(4, 1), 0.7)
+			rng := rand.New(rand.NewSource(4))
+			pts := rect.Corners()
+			points := append([]v.Vect(nil), pts[:]...)
+			tr := rect.Transform()
+			for i := 0; i < 100; i++ {
+				l := v.V(f.Float(rng.Float64()*8-4), f.Float(rng.Float64()*2-1))
+				points = append(points, tr.Point(l))
+			}
+			box, _ = ForPoints(points)
+			So(box.Area(), ShouldAlmostEqual, rect.Area(), 1e-3)
+			So(box.Center, mathtest.ShouldApproxEqual, rect.Center, 1e-4)
+			So(box.Area(), ShouldBeLessThan, ForTransform(t.Identity(), rect.AABB()).Area())
+		})
+		Convey("ForPoints finds the minimum over all hull edges", func() {
+			rng := rand.New(rand.NewSource(5))
+			for k := 0; k < 50; k++ {
+				var points []v.Vect
+				for i := 0; i < 3+k; i++ {
+					points = append(points, v.V(f.Float(rng.NormFloat64()*3), f.Float(rng.NormFloat64())))
+				}
+				box, _ := ForPoints(points)
+				for _, p := range points {
+					So(containsApprox(box, p, 1e-4), ShouldBeTrue)
+				}
+
+				hull := geom.ConvexHull(points)
+				best := f.Inf
+				for i, p := range hull {
+					edge := v.Normalize(v.Sub(hull[(i+1)%len(hull)], p))
+					best = f.Min(best, enclose(hull, edge).Area())
+				}
+				So(box.Area(), ShouldAlmostEqual, best, 1e-3)
+			}
+		})
+	})
+}
@@ -0,0 +1,141 @@
+package robust
+
+import "math"
+
+// Floating point expansion arithmetic after Shewchuk's predicates.c.
+// An expansion is a sum of nonoverlapping float64 components ordered by
+// increasing magnitude, representing a value exactly.
+//
+// Products are converted with float64() so the compiler cannot fuse them
+// into FMA instructions, the error analysis assumes separate roundings.
+
+const (
+	epsilon = 0x1p-53
+
+	resultErrBound = (3 + 8*epsilon) * epsilon
+	ccwErrBoundA   = (3 + 16*epsilon) * epsilon
+	ccwErrBoundB   = (2 + 12*epsilon) * epsilon
+	ccwErrBoundC   = (9 + 64*epsilon) * epsilon * epsilon
+	iccErrBoundA   = (10 + 96*epsilon) * epsilon
+)
+
+// Sum a + b as x + y exactly, x being the rounded sum.
+func twoSum(a, b float64) (x, y float64) {
+	x = a + b
+	bv := x - a
+	av := x - bv
+	y = (a - av) + (b - bv)
+	return
+}
+
+// Same as twoSum for |a| >= |b|.
+func fastTwoSum(a, b float64) (x, y float64) {
+	x = a + b
+	y = b - (x - a)
+	return
+}
+
+// Difference a - b as x + y exactly.
+func twoDiff(a, b float64) (x, y float64) {
+	x = a - b
+	return x, twoDiffTail(a, b, x)
+}
+
+// Roundoff error of the difference x = a - b.
+func twoDiffTail(a, b, x float64) float64 {
+	bv := a - x
+	av := x + bv
+	return (a - av) + (bv - b)
+}
+
+// Product a * b as x + y exactly.
+func twoProduct(a, b float64) (x, y float64) {
+	x = float64(a * b)
+	return x, math.FMA(a, b, -x)
+}
+
+// Difference (a1 + a0) - (b1 + b0) as a four component expansion.
+func twoTwoDiff(a1, a0, b1, b0 float64) []float64 {
+	i, x0 := twoDiff(a0, b0)
+	j, z := twoSum(a1, i)
+	i, x1 := twoDiff(z, b1)
+	x3, x2 := twoSum(j, i)
+	return []float64{x0, x1, x2, x3}
+}
+
+// Exact difference of two products, a*b - c*d, as a four component expansion.
+func productDiff(a, b, c, d float64) []float64 {
+	s1, s0 := twoProduct(a, b)
+	t1, t0 := twoProduct(c, d)
+	return twoTwoDiff(s1, s0, t1, t0)
+}
+
+// Sum of two expansions with zero components removed.
+// The result has at least one component.
+func expansionSum(e, f []float64) []float64 {
+	h := make([]float64, 0, len(e)+len(f))
+	ei, fi := 0, 0
+	// Take the component of smaller magnitude next.
+	next := func() float64 {
+		if fi == len(f) || ei < len(e) && (f[fi] > e[ei]) == (f[fi] > -e[ei]) {
+			ei++
+			return e[ei-1]
+		}
+		fi++
+		return f[fi-1]
+	}
+
+	q := next()
+	for ei < len(e) || fi < len(f) {
+		var hh float64
+		q, hh = twoSum(q, next())
+		if hh != 0 {
+			h = append(h, hh)
+		}
+	}
+	if q != 0 || len(h) == 0 {
+		h = append(h, q)
+	}
+	return h
+}
+
+// Product of an expansion and a float64 with zero components removed.
+func scaleExpansion(e []float64, b float64) []float64 {
+	h := make([]float64, 0, 2*len(e))
+	q, hh := twoProduct(e[0], b)
+	if hh != 0 {
+		h = append(h, hh)
+	}
+	for _, x := range e[1:] {
+		p1, p0 := twoProduct(x, b)
+		sum, hh := twoSum(q, p0)
+		if hh != 0 {
+			h = append(h, hh)
+		}
+		q, hh = fastTwoSum(p1, sum)
+		if hh != 0 {
+			h = append(h, hh)
+		}
+	}
+	if q != 0 || len(h) == 0 {
+		h = append(h, q)
+	}
+	return h
+}
+
+func negate(e []float64) []float64 {
+	n := make([]float64, len(e))
+	for i, x := range e {
+		n[i] = -x
+	}
+	return n
+}
+
+// Approximate value of an expansion.
+func estimate(e []float64) float64 {
+	var sum float64
+	for _, x := range e {
+		sum += x
+	}
+	return sum
+}
@@ -0,0 +1,150 @@
+// Robust geometric predicates with adaptive precision after
+// Jonathan Shewchuk, "Adaptive Precision Floating-Point Arithmetic and
+// Fast Robust Geometric Predicates".
+//
+// Each predicate first evaluates the determinant in float64 with an error
+// bound and only falls back to exact expansion arithmetic when the sign
+// is in doubt. The sign of the result is always exact for the float64
+// values of the inputs, which includes every float32 input.
+package robust
+
+import "github.com/oniproject/math/v"
+
+// Orientation determinant of the points a, b and c.
+// Positive if they are in counterclockwise order, negative if clockwise
+// and zero if collinear. The magnitude approximates twice the signed
+// area of the triangle.
+func Orient2D(a, b, c v.Vect) float64 {
+	return orient2d(
+		float64(a.X), float64(a.Y),
+		float64(b.X), float64(b.Y),
+		float64(c.X), float64(c.Y),
+	)
+}
+
+// In-circle determinant of the point d against the circle through a, b and c.
+// For counterclockwise a, b, c it is positive if d lies inside the circle,
+// negative outside and zero on it. The sign flips for clockwise a, b, c.
+func InCircle(a, b, c, d v.Vect) float64 {
+	return incircle(
+		float64(a.X), float64(a.Y),
+		float64(b.X), float64(b.Y),
+		float64(c.X), float64(c.Y),
+		float64(d.X), float64(d.Y),
+	)
+}
+
+func abs(x float64) float64 {
+	if x < 0 {
+		return -x
+	}
+	return x
+}
+
+func orient2d(ax, ay, bx, by, cx, cy float64) float64 {
+	detLeft := float64((ax - cx) * (by - cy))
+	detRight := float64((ay - cy) * (bx - cx))
+	det := detLeft - detRight
+
+	var detSum float64
+	switch {
+	case detLeft > 0:
+		if detRight <= 0 {
+			return det
+		}
+		detSum = detLeft + detRight
+	case detLeft < 0:
+		if detRight >= 0 {
+			return det
+		}
+		detSum = -detLeft - detRight
+	default:
+		return det
+	}
+
+	if errBound := ccwErrBoundA * detSum; det >= errBound || -det >= errBound {
+		return det
+	}
+	return orient2dAdapt(ax, ay, bx, by, cx, cy, detSum)
+}
+
+func orient2dAdapt(ax, ay, bx, by, cx, cy, detSum float64) float64 {
+	acx, bcx := ax-cx, bx-cx
+	acy, bcy := ay-cy, by-cy
+
+	b := productDiff(acx, bcy, acy, bcx)
+	det := estimate(b)
+	if errBound := ccwErrBoundB * detSum; det >= errBound || -det >= errBound {
+		return det
+	}
+
+	acxTail := twoDiffTail(ax, cx, acx)
+	bcxTail := twoDiffTail(bx, cx, bcx)
+	acyTail := twoDiffTail(ay, cy, acy)
+	bcyTail := twoDiffTail(by, cy, bcy)
+	if acxTail == 0 && acyTail == 0 && bcxTail == 0 && bcyTail == 0 {
+		// The differences are exact and so is b.
+		return det
+	}
+
+	errBound := ccwErrBoundC*detSum + resultErrBound*abs(det)
+	det += (float64(acx*bcyTail) + float64(bcy*acxTail)) - (float64(acy*bcxTail) + float64(bcx*acyTail))
+	if det >= errBound || -det >= errBound {
+		return det
+	}
+
+	c1 := expansionSum(b, productDiff(acxTail, bcy, acyTail, bcx))
+	c2 := expansionSum(c1, productDiff(acx, bcyTail, acy, bcxTail))
+	d := expansionSum(c2, productDiff(acxTail, bcyTail, acyTail, bcxTail))
+	return d[len(d)-1]
+}
+
+func incircle(ax, ay, bx, by, cx, cy, dx, dy float64) float64 {
+	adx, bdx, cdx := ax-dx, bx-dx, cx-dx
+	ady, bdy, cdy := ay-dy, by-dy, cy-dy
+
+	bdxcdy, cdxbdy := float64(bdx*cdy), float64(cdx*bdy)
+	aLift := float64(adx*adx) + float64(ady*ady)
+	cdxady, adxcdy := float64(cdx*ady), float64(adx*cdy)
+	bLift := float64(bdx*bdx) + float64(bdy*bdy)
+	adxbdy, bdxady := float64(adx*bdy), float64(bdx*ady)
+	cLift := float64(cdx*cdx) + float64(cdy*cdy)
+
+	det := float64(aLift*(bdxcdy-cdxbdy)) + float64(bLift*(cdxady-adxcdy)) + float64(cLift*(adxbdy-bdxady))
+	permanent := float64((abs(bdxcdy)+abs(cdxbdy))*aLift) +
+		float64((abs(cdxady)+abs(adxcdy))*bLift) +
+		float64((abs(adxbdy)+abs(bdxady))*cLift)
+	if errBound := iccErrBoundA * permanent; det > errBound || -det > errBound {
+		return det
+	}
+	return incircleExact(ax, ay, bx, by, cx, cy, dx, dy)
+}
+
+// Exact in-circle determinant expanded by minors of the untranslated points.
+func incircleExact(ax, ay, bx, by, cx, cy, dx, dy float64) float64 {
+	ab := productDiff(ax, by, bx, ay)
+	bc := productDiff(bx, cy, cx, by)
+	cd := productDiff(cx, dy, dx, cy)
+	da := productDiff(dx, ay, ax, dy)
+	ac := productDiff(ax, cy, cx, ay)
+	bd := productDiff(bx, dy, dx, by)
+
+	cda := expansionSum(expansionSum(cd, da), ac)
+	dab := expansionSum(expansionSum(da, ab), bd)
+	abc := expansionSum(expansionSum(ab, bc), negate(ac))
+	bcd := expansionSum(expansionSum(bc, cd), negate(bd))
+
+	lift := func(e []float64, x, y float64) []float64 {
+		return expansionSum(
+			scaleExpansion(scaleExpansion(e, x), x),
+			scaleExpansion(scaleExpansion(e, y), y),
+		)
+	}
+	aDet := lift(bcd, ax, ay)
+	bDet := negate(lift(cda, bx, by))
+	cDet := lift(dab, cx, cy)
+	dDet := negate(lift(abc, dx, dy))
+
+	det := expansionSum(expansionSum(aDet, bDet), expansionSum(cDet, dDet))
+	return det[len(det)-1]
+}
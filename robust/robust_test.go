@@ -0,0 +1,178 @@
+package robust
+
+import (
+	"github.com/oniproject/math/v"
+	. "github.com/smartystreets/goconvey/convey"
+	"math"
+	"math/big"
+	"math/rand"
+	"testing"
+)
+
+func rat(x float64) *big.Rat { return new(big.Rat).SetFloat64(x) }
+
+// Exact sign of the orientation determinant.
+func orientExact(ax, ay, bx, by, cx, cy float64) int {
+	l := new(big.Rat).Mul(new(big.Rat).Sub(rat(ax), rat(cx)), new(big.Rat).Sub(rat(by), rat(cy)))
+	r := new(big.Rat).Mul(new(big.Rat).Sub(rat(ay), rat(cy)), new(big.Rat).Sub(rat(bx), rat(cx)))
+	return l.Cmp(r)
+}
+
+// Exact sign of the in-circle determinant.
+func incircleExactSign(p [4][2]float64) int {
+	var m [3][3]*big.Rat
+	for i := 0; i < 3; i++ {
+		x := new(big.Rat).Sub(rat(p[i][0]), rat(p[3][0]))
+		y := new(big.Rat).Sub(rat(p[i][1]), rat(p[3][1]))
+		lift := new(big.Rat).Add(new(big.Rat).Mul(x, x), new(big.Rat).Mul(y, y))
+		m[i] = [3]*big.Rat{x, y, lift}
+	}
+	minor := func(i, j int) *big.Rat {
+		return new(big.Rat).Sub(
+			new(big.Rat).Mul(m[i][0], m[j][1]),
+			new(big.Rat).Mul(m[j][0], m[i][1]))
+	}
+	det := new(big.Rat).Mul(m[0][2], minor(1, 2))
+	det.Add(det, new(big.Rat).Mul(m[1][2], minor(2, 0)))
+	det.Add(det, new(big.Rat).Mul(m[2][2], minor(0, 1)))
+	return det.Sign()
+}
+
+func sign(x float64) int {
+	switch {
+	case x > 0:
+		return 1
+	case x < 0:
+		return -1
+	}
+	return 0
+}
+
+func TestExpansion(test *testing.T) {
+	Convey("Expansion arithmetic", test, func() {
+		x, y := twoSum(1, 0x1p-60)
+		So(x, ShouldEqual, 1)
+		So(y, ShouldEqual, 0x1p-60)
+
+		x, y = twoProduct(1+0x1p-30, 1+0x1p-30)
+		So(x, ShouldEqual, 1+0x1p-29)
+		So(y, ShouldEqual, 0x1p-60)
+
+		e := expansionSum([]float64{0x1p-60, 1}, []float64{-1})
+		So(e, ShouldResemble, []float64{0x1p-60})
+		e = expansionSum([]float64{1}, []float64{-1})
+		So(e, ShouldResemble, []float64{0})
+
+		e = scaleExpansion([]float64{0x1p-60, 1}, 3)
+		So(estimate(e), ShouldEqual, 3)
+		So(e[0], ShouldEqual, 3*0x1p-60)
+	})
+}
+
+func TestOrient2D(test *testing.T) {
+	Convey("Orient2D", test, func() {
+		So(Orient2D(v.V(0, 0), v.V(1, 0), v.V(0, 1)), ShouldEqual, 1)
+		So(Orient2D(v.V(0, 0), v.V(0, 1), v.V(1, 0)), ShouldEqual, -1)
+		So(Orient2D(v.V(0, 0), v.V(1, 1), v.V(2, 2)), ShouldEqual, 0)
+
+		Convey("Near collinear grid", func() {
+			// The classic example where the naive determinant gets the
+			// sign wrong for a large part of a tiny grid around a line.
+			wrong := 0
+			for i := 0; i < 64; i++ {
+				for j := 0; j < 64; j++ {
+					ax := 0.5 + float64(i)*0x1p-53
+					ay := 0.5 + float64(j)*0x1p-53
+					want := orientExact(ax, ay, 12, 12, 24, 24)
+					So(sign(orient2d(ax, ay, 12, 12, 24, 24)), ShouldEqual, want)
+					naive := (12-ax)*(24-ay) - (12-ay)*(24-ax)
+					if sign(naive) != want {
+						wrong++
+					}
+				}
+			}
+			test.Logf("naive determinant wrong for %d of 4096 points", wrong)
+			So(wrong, ShouldBeGreaterThan, 0)
+		})
+		Convey("Random near degenerate", func() {
+			rng := rand.New(rand.NewSource(1))
+			for i := 0; i < 2000; i++ {
+				ax, ay := rng.Float64()*100, rng.Float64()*100
+				bx, by := rng.Float64()*100, rng.Float64()*100
+				t := rng.Float64()*3 - 1
+				cx := ax + (bx-ax)*t
+				cy := ay + (by-ay)*t
+				// Nudge by a few ULPs.
+				for k := rng.Intn(3); k > 0; k-- {
+					cx = math.Nextafter(cx, math.Inf(rng.Intn(2)*2-1))
+				}
+				want := orientExact(ax, ay, bx, by, cx, cy)
+				So(sign(orient2d(ax, ay, bx, by, cx, cy)), ShouldEqual, want)
+				So(sign(orient2d(bx, by, cx, cy, ax, ay)), ShouldEqual, want)
+			}
+		})
+	})
+}
+
+func TestInCircle(test *testing.T) {
+	Convey("InCircle", test, func() {
+		a, b, c := v.V(0, 0), v.V(1, 0), v.V(0, 1)
+		So(InCircle(a, b, c, v.V(0.5, 0.5)), ShouldBeGreaterThan, 0)
+		So(InCircle(a, b, c, v.V(2, 2)), ShouldBeLessThan, 0)
+		So(InCircle(a, b, c, v.V(1, 1)), ShouldEqual, 0)
+		So(InCircle(a, c, b, v.V(0.5, 0.5)), ShouldBeLessThan, 0)
+
+		Convey("Random near cocircular", func() {
+			rng := rand.New(rand.NewSource(2))
+			for i := 0; i < 1000; i++ {
+				var p [4][2]float64
+				cx, cy, r := rng.Float64()*10, rng.Float64()*10, 1+rng.Float64()*10
+				for k := range p {
+					s, c := math.Sincos(rng.Float64() * 2 * math.Pi)
+					p[k] = [2]float64{cx + r*c, cy + r*s}
+				}
+				if i%2 == 0 {
+					p[3][1] = math.Nextafter(p[3][1], math.Inf(1))
+				}
+				want := incircleExactSign(p)
+				got := incircle(p[0][0], p[0][1], p[1][0], p[1][1], p[2][0], p[2][1], p[3][0], p[3][1])
+				So(sign(got), ShouldEqual, want)
+				exact := incircleExact(p[0][0], p[0][1], p[1][0], p[1][1], p[2][0], p[2][1], p[3][0], p[3][1])
+				So(sign(exact), ShouldEqual, want)
+			}
+		})
+		Convey("Exactly cocircular", func() {
+			// Integer points on the circle of radius 5.
+			pts := []v.Vect{v.V(5, 0), v.V(3, 4), v.V(0, 5), v.V(-4, 3), v.V(-5, 0), v.V(0, -5), v.V(4, -3)}
+			for i := 3; i < len(pts); i++ {
+				So(InCircle(pts[0], pts[1], pts[2], pts[i]), ShouldEqual, 0)
+			}
+		})
+	})
+}
+
+var sink float64
+
+func BenchmarkOrient2D(b *testing.B) {
+	for i := 0; i < b.N; i++ {
+		sink += orient2d(float64(i&1023), 1, 12, 12, 24, 25)
+	}
+}
+
+func BenchmarkOrient2DDegenerate(b *testing.B) {
+	for i := 0; i < b.N; i++ {
+		sink += orient2d(0.5+float64(i&63)*0x1p-53, 0.5, 12, 12, 24, 24)
+	}
+}
+
+func BenchmarkInCircle(b *testing.B) {
+	for i := 0; i < b.N; i++ {
+		sink += incircle(0, 0, 1, 0, 0, 1, float64(i&1023)*0.001, 0.5)
+	}
+}
+
+func BenchmarkInCircleDegenerate(b *testing.B) {
+	for i := 0; i < b.N; i++ {
+		sink += incircle(5, 0, 3, 4, 0, 5, -4, 3)
+	}
+}
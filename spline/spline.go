@@ -0,0 +1,333 @@
+// Catmull-Rom, cubic Hermite and cubic B-spline curves over v.Vect.
+//
+// Every spline is a sequence of cubic segments and converts to a Path of
+// bezier.Cubic. A spline is parameterized by t in [0, 1] over all segments,
+// so it can be passed to bezier.NewArcLength for constant-speed traversal.
+package spline
+
+import "github.com/oniproject/math/bezier"
+import "github.com/oniproject/math/f"
+import "github.com/oniproject/math/v"
+
+// Common Catmull-Rom parameterizations.
+const (
+	Uniform     f.Float = 0.0
+	Centripetal f.Float = 0.5
+	Chordal     f.Float = 1.0
+)
+
+// A curve made of cubic segments.
+type Spline interface {
+	Len() int
+	Segment(i int) bezier.Cubic
+}
+
+// Sequence of cubic Bezier segments parameterized by t in [0, 1].
+type Path []bezier.Cubic
+
+// Converts any spline to a path of cubic Bezier segments.
+func ToPath(s Spline) Path {
+	p := make(Path, s.Len())
+	for i := range p {
+		p[i] = s.Segment(i)
+	}
+	return p
+}
+
+// Returns the number of segments.
+func (p Path) Len() int { return len(p) }
+
+// Returns segment i.
+func (p Path) Segment(i int) bezier.Cubic { return p[i] }
+
+// Returns the point at t.
+func (p Path) Point(t f.Float) v.Vect { return Point(p, t) }
+
+// Returns the derivative with respect to t.
+func (p Path) Derivative(t f.Float) v.Vect { return Derivative(p, t) }
+
+// Maps the global parameter t in [0, 1] to a segment and its local parameter.
+func locate(n int, t f.Float) (int, f.Float) {
+	u := f.Clamp01(t) * f.Float(n)
+	i := int(u)
+	if i >= n {
+		i = n - 1
+	}
+	return i, u - f.Float(i)
+}
+
+// Returns the point of s at t in [0, 1].
+func Point(s Spline, t f.Float) v.Vect {
+	n := s.Len()
+	if n == 0 {
+		return v.Zero()
+	}
+	i, u := locate(n, t)
+	return s.Segment(i).Point(u)
+}
+
+// Returns the derivative of s with respect to t in [0, 1].
+func Derivative(s Spline, t f.Float) v.Vect {
+	n := s.Len()
+	if n == 0 {
+		return v.Zero()
+	}
+	i, u := locate(n, t)
+	return v.Mult(s.Segment(i).Derivative(u), f.Float(n))
+}
+
+// Returns the unit tangent of s at t in [0, 1].
+func Tangent(s Spline, t f.Float) v.Vect {
+	return v.Normalize(Derivative(s, t))
+}
+
+// Converts a cubic Hermite segment to a cubic Bezier.
+func hermite(p0, m0, p1, m1 v.Vect) bezier.Cubic {
+	return bezier.Cubic{
+		P0: p0,
+		P1: v.Add(p0, v.Mult(m0, 1.0/3.0)),
+		P2: v.Sub(p1, v.Mult(m1, 1.0/3.0)),
+		P3: p1,
+	}
+}
+
+// Cubic Hermite spline through Points with the given Tangents.
+type Hermite struct {
+	Points, Tangents []v.Vect
+}
+
+// Returns the number of segments.
+func (h Hermite) Len() int {
+	if len(h.Points) < 2 {
+		return 0
+	}
+	return len(h.Points) - 1
+}
+
+// Returns segment i as a cubic Bezier.
+func (h Hermite) Segment(i int) bezier.Cubic {
+	return hermite(h.Points[i], h.Tangents[i], h.Points[i+1], h.Tangents[i+1])
+}
+
+// Returns the point at t in [0, 1].
+func (h Hermite) Point(t f.Float) v.Vect { return Point(h, t) }
+
+// Returns the derivative with respect to t in [0, 1].
+func (h Hermite) Derivative(t f.Float) v.Vect { return Derivative(h, t) }
+
+// Catmull-Rom spline passing through all Points.
+// Alpha selects the knot parameterization: Uniform, Centripetal or Chordal.
+// The end segments use mirrored phantom points.
+type CatmullRom struct {
+	Points []v.Vect
+	Alpha  f.Float
+}
+
+// Returns the number of segments.
+func (c CatmullRom) Len() int {
+	if len(c.Points) < 2 {
+		return 0
+	}
+	return len(c.Points) - 1
+}
+
+func (c CatmullRom) point(i int) v.Vect {
+	n := len(c.Points)
+	switch {
+	case i < 0:
+		return v.Sub(v.Mult(c.Points[0], 2.0), c.Points[1])
+	case i >= n:
+		return v.Sub(v.Mult(c.Points[n-1], 2.0), c.Points[n-2])
+	}
+	return c.Points[i]
+}
+
+// Knot interval between two points.
+func (c CatmullRom) knot(a, b v.Vect) f.Float {
+	if c.Alpha == 0.0 {
+		return 1.0
+	}
+	d := f.Pow(v.DistSq(a, b), c.Alpha*0.5)
+	if d < 1e-6 {
+		return 1.0
+	}
+	return d
+}
+
+// Returns segment i as a cubic Bezier.
+func (c CatmullRom) Segment(i int) bezier.Cubic {
+	p0, p1, p2, p3 := c.point(i-1), c.point(i), c.point(i+1), c.point(i+2)
+	dt0, dt1, dt2 := c.knot(p0, p1), c.knot(p1, p2), c.knot(p2, p3)
+
+	// Tangents of the non-uniform curve rescaled to the [0, 1] segment.
+	m1 := v.Add(
+		v.Sub(v.Mult(v.Sub(p1, p0), 1.0/dt0), v.Mult(v.Sub(p2, p0), 1.0/(dt0+dt1))),
+		v.Mult(v.Sub(p2, p1), 1.0/dt1),
+	)
+	m2 := v.Add(
+		v.Sub(v.Mult(v.Sub(p2, p1), 1.0/dt1), v.Mult(v.Sub(p3, p1), 1.0/(dt1+dt2))),
+		v.Mult(v.Sub(p3, p2), 1.0/dt2),
+	)
+	return hermite(p1, v.Mult(m1, dt1), p2, v.Mult(m2, dt1))
+}
+
+// Returns the point at t in [0, 1].
+func (c CatmullRom) Point(t f.Float) v.Vect { return Point(c, t) }
+
+// Returns the derivative with respect to t in [0, 1].
+func (c CatmullRom) Derivative(t f.Float) v.Vect { return Derivative(c, t) }
+
+// Cubic B-spline with control Points and non-decreasing Knots.
+// len(Knots) must be len(Points)+4.
+// The curve is defined on [Knots[3], Knots[len(Points)]].
+type BSpline struct {
+	Points []v.Vect
+	Knots  []f.Float
+}
+
+// Uniform cubic B-spline with integer knots.
+func UniformBSpline(points []v.Vect) BSpline {
+	knots := make([]f.Float, len(points)+4)
+	for i := range knots {
+		knots[i] = f.Float(i)
+	}
+	return BSpline{points, knots}
+}
+
+// Cubic B-spline with end knots of multiplicity 4 and uniform interior knots,
+// the curve starts at the first control point and ends at the last one.
+func ClampedBSpline(points []v.Vect) BSpline {
+	n := len(points)
+	knots := make([]f.Float, n+4)
+	for i := range knots {
+		knots[i] = f.Float(i - 3)
+		if i < 3 {
+			knots[i] = 0.0
+		}
+		if i > n {
+			knots[i] = f.Float(n - 3)
+		}
+	}
+	return BSpline{points, knots}
+}
+
+// Returns the number of segments, including empty ones at repeated knots.
+func (b BSpline) Len() int {
+	if len(b.Points) < 4 {
+		return 0
+	}
+	return len(b.Points) - 3
+}
+
+// Evaluates the blossom of span k at the arguments u.
+func (b BSpline) blossom(k int, u [3]f.Float) v.Vect {
+	var d [4]v.Vect
+	copy(d[:], b.Points[k-3:k+1])
+	for r := 1; r <= 3; r++ {
+		for j := 3; j >= r; j-- {
+			i := k - 3 + j
+			lo, hi := b.Knots[i], b.Knots[i+4-r]
+			a := f.Float(0.0)
+			if hi != lo {
+				a = (u[r-1] - lo) / (hi - lo)
+			}
+			d[j] = v.Lerp(d[j-1], d[j], a)
+		}
+	}
+	return d[3]
+}
+
+// Returns segment i, the span [Knots[i+3], Knots[i+4]], as a cubic Bezier.
+func (b BSpline) Segment(i int) bezier.Cubic {
+	k := i + 3
+	lo, hi := b.Knots[k], b.Knots[k+1]
+	return bezier.Cubic{
+		P0: b.blossom(k, [3]f.Float{lo, lo, lo}),
+		P1: b.blossom(k, [3]f.Float{lo, lo, hi}),
+		P2: b.blossom(k, [3]f.Float{lo, hi, hi}),
+		P3: b.blossom(k, [3]f.Float{hi, hi, hi}),
+	}
+}
+
+// Returns the point at knot value u using de Boor's algorithm.
+func (b BSpline) At(u f.Float) v.Vect {
+	n := len(b.Points)
+	k := 3
+	for k < n-1 && u >= b.Knots[k+1] {
+		k++
+	}
+	return b.blossom(k, [3]f.Float{u, u, u})
+}
+
+// Returns the point at t in [0, 1], mapped linearly onto the knot domain.
+func (b BSpline) Point(t f.Float) v.Vect {
+	if b.Len() == 0 {
+		return v.Zero()
+	}
+	lo, hi := b.Knots[3], b.Knots[len(b.Points)]
+	return b.At(f.Lerp(lo, hi, f.Clamp01(t)))
+}
+
+// Returns the derivative with respect to t in [0, 1].
+func (b BSpline) Derivative(t f.Float) v.Vect {
+	if b.Len() == 0 {
+		return v.Zero()
+	}
+	n := len(b.Points)
+	lo, hi := b.Knots[3], b.Knots[n]
+	u := f.Lerp(lo, hi, f.Clamp01(t))
+
+	k := 3
+	for k < n-1 && u >= b.Knots[k+1] {
+		k++
+	}
+	s0, s1 := b.Knots[k], b.Knots[k+1]
+	if s1 == s0 {
+		return v.Zero()
+	}
+	c := b.Segment(k - 3)
+	return v.Mult(c.Derivative((u-s0)/(s1-s0)), (hi-lo)/(s1-s0))
+}
+
+// Returns the cubic Bezier path tracing the same curve, skipping empty spans.
+//
+// The parameterization differs for non-uniform knots: Path gives every
+// segment an equal share of t, while Point maps t linearly onto the knots.
+// Use PathT to convert a t of Point into the t of Path.
+func (b BSpline) Path() Path {
+	var p Path
+	for i := 0; i < b.Len(); i++ {
+		if b.Knots[i+3] != b.Knots[i+4] {
+			p = append(p, b.Segment(i))
+		}
+	}
+	return p
+}
+
+// Converts t of Point and Derivative into the t of Path at the same point.
+func (b BSpline) PathT(t f.Float) f.Float {
+	if b.Len() == 0 {
+		return 0.0
+	}
+	n := len(b.Points)
+	u := f.Lerp(b.Knots[3], b.Knots[n], f.Clamp01(t))
+
+	// Find the last non-empty span starting at or before u,
+	// counting the non-empty spans before it.
+	k, j, count := -1, -1, 0
+	for i := 3; i < n; i++ {
+		if b.Knots[i] == b.Knots[i+1] {
+			continue
+		}
+		if k < 0 || u >= b.Knots[i] {
+			k, j = i, count
+		}
+		count++
+	}
+	if count == 0 {
+		return 0.0
+	}
+	s0, s1 := b.Knots[k], b.Knots[k+1]
+	local := f.Clamp01((u - s0) / (s1 - s0))
+	return (f.Float(j) + local) / f.Float(count)
+}
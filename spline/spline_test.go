@@ -0,0 +1,105 @@
+package spline
+
+import (
+	"github.com/oniproject/math/bezier"
+	"github.com/oniproject/math/f"
+	"github.com/oniproject/math/v"
+	. "github.com/smartystreets/goconvey/convey"
+	"testing"
+)
+
+func shouldBeNear(a, b v.Vect) {
+	So(a.X, ShouldAlmostEqual, b.X, 1e-4)
+	So(a.Y, ShouldAlmostEqual, b.Y, 1e-4)
+}
+
+func TestSpline(test *testing.T) {
+	Convey("Spline", test, func() {
+		pts := []v.Vect{v.V(0, 0), v.V(1, 2), v.V(3, 3), v.V(4, 0), v.V(6, 1)}
+
+		Convey("CatmullRom", func() {
+			for _, alpha := range []f.Float{Uniform, Centripetal, Chordal} {
+				c := CatmullRom{pts, alpha}
+				So(c.Len(), ShouldEqual, 4)
+				for i := range pts {
+					shouldBeNear(c.Point(f.Float(i)/4), pts[i])
+				}
+				// Tangent continuity at the joints.
+				for i := 0; i < c.Len()-1; i++ {
+					a, b := c.Segment(i), c.Segment(i+1)
+					shouldBeNear(v.Normalize(a.Derivative(1)), v.Normalize(b.Derivative(0)))
+				}
+			}
+
+			// Uniform tangents are half the neighbour difference.
+			c := CatmullRom{pts, Uniform}
+			shouldBeNear(c.Segment(1).Derivative(0), v.Mult(v.Sub(pts[2], pts[0]), 0.5))
+		})
+
+		Convey("Hermite", func() {
+			h := Hermite{
+				Points:   []v.Vect{v.V(0, 0), v.V(2, 0)},
+				Tangents: []v.Vect{v.V(3, 3), v.V(3, -3)},
+			}
+			So(h.Len(), ShouldEqual, 1)
+			shouldBeNear(h.Point(0), v.V(0, 0))
+			shouldBeNear(h.Point(1), v.V(2, 0))
+			shouldBeNear(h.Derivative(0), v.V(3, 3))
+			shouldBeNear(h.Derivative(1), v.V(3, -3))
+		})
+
+		Convey("BSpline", func() {
+			b := UniformBSpline(pts)
+			So(b.Len(), ShouldEqual, 2)
+			// A uniform cubic B-spline starts at (P0 + 4 P1 + P2) / 6.
+			want := v.Mult(v.Add(v.Add(pts[0], v.Mult(pts[1], 4)), pts[2]), 1.0/6.0)
+			shouldBeNear(b.Point(0), want)
+
+			for _, u := range []f.Float{0, 0.2, 0.5, 0.7, 1} {
+				shouldBeNear(ToPath(b).Point(u), b.Point(u))
+				shouldBeNear(ToPath(b).Derivative(u), b.Derivative(u))
+			}
+
+			c := ClampedBSpline(pts)
+			shouldBeNear(c.Point(0), pts[0])
+			shouldBeNear(c.Point(1), pts[4])
+			shouldBeNear(c.Path().Point(0.3), c.Point(0.3))
+
+			nu := BSpline{pts, []f.Float{0, 0, 0, 0, 1, 3, 3, 3, 3}}
+			So(len(nu.Path()), ShouldEqual, 2)
+			shouldBeNear(nu.Point(0), pts[0])
+			shouldBeNear(nu.Point(1), pts[4])
+			shouldBeNear(nu.Path()[1].Point(0), nu.At(1))
+
+			// The spans cover a third and two thirds of the knot domain but
+			// get half of t each on the path.
+			path := nu.Path()
+			So(nu.PathT(1.0/3.0), ShouldAlmostEqual, 0.5, 1e-5)
+			So(nu.PathT(0), ShouldEqual, 0)
+			So(nu.PathT(1), ShouldEqual, 1)
+			p, q := nu.Point(0.2), path.Point(0.2)
+			So(v.Dist(p, q), ShouldBeGreaterThan, 1e-3)
+			for i := 0; i <= 10; i++ {
+				t := f.Float(i) / 10
+				shouldBeNear(path.Point(nu.PathT(t)), nu.Point(t))
+			}
+		})
+
+		Convey("Constant speed", func() {
+			c := CatmullRom{pts, Centripetal}
+			path := ToPath(c)
+			a := bezier.NewArcLength(path, 1024)
+			step := a.Length() / 10
+			for i := 1; i <= 10; i++ {
+				t0, t1 := a.T(step*f.Float(i-1)), a.T(step*f.Float(i))
+				var d f.Float
+				for j := 0; j < 100; j++ {
+					d += v.Dist(
+						path.Point(f.Lerp(t0, t1, f.Float(j)/100)),
+						path.Point(f.Lerp(t0, t1, f.Float(j+1)/100)))
+				}
+				So(d, ShouldAlmostEqual, step, step*0.01)
+			}
+		})
+	})
+}
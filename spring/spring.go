@@ -0,0 +1,110 @@
+// Analytic damped harmonic oscillators for f.Float and v.Vect.
+//
+// Unlike explicit integration the closed form solution is stable for any time step.
+// (Ryan Juckett, Damped Springs)
+package spring
+
+import "github.com/oniproject/math/f"
+import "github.com/oniproject/math/v"
+
+// Damped spring pulling a value towards a target.
+type Spring struct {
+	// Angular frequency in radians per second.
+	Frequency f.Float
+	// Damping ratio: < 1 under-damped, 1 critically damped, > 1 over-damped.
+	Damping f.Float
+}
+
+// Convenience constructor for Spring structs.
+func New(frequency, damping f.Float) Spring {
+	return Spring{frequency, damping}
+}
+
+// Precomputed motion of a spring over a fixed time step.
+type Coef struct {
+	PosPos, PosVel, VelPos, VelVel f.Float
+}
+
+// Returns the coefficients advancing the spring by dt.
+func (s Spring) Coef(dt f.Float) Coef {
+	const epsilon = 0.0001
+
+	omega := f.Max(s.Frequency, 0.0)
+	zeta := f.Max(s.Damping, 0.0)
+	if omega < epsilon {
+		return Coef{1.0, 0.0, 0.0, 1.0}
+	}
+
+	switch {
+	case zeta > 1.0+epsilon:
+		// Over-damped.
+		za := -omega * zeta
+		zb := omega * f.Sqrt(zeta*zeta-1.0)
+		z1, z2 := za-zb, za+zb
+		e1, e2 := f.Exp(z1*dt), f.Exp(z2*dt)
+
+		inv := 1.0 / (2.0 * zb)
+		e1Over, e2Over := e1*inv, e2*inv
+		z1e1Over, z2e2Over := z1*e1Over, z2*e2Over
+
+		return Coef{
+			PosPos: e1Over*z2 - z2e2Over + e2,
+			PosVel: -e1Over + e2Over,
+			VelPos: (z1e1Over - z2e2Over + e2) * z2,
+			VelVel: -z1e1Over + z2e2Over,
+		}
+
+	case zeta < 1.0-epsilon:
+		// Under-damped.
+		omegaZeta := omega * zeta
+		alpha := omega * f.Sqrt(1.0-zeta*zeta)
+
+		exp := f.Exp(-omegaZeta * dt)
+		cos, sin := f.Cos(alpha*dt), f.Sin(alpha*dt)
+		expSin, expCos := exp*sin, exp*cos
+		expOmegaZetaSinOverAlpha := exp * omegaZeta * sin / alpha
+
+		return Coef{
+			PosPos: expCos + expOmegaZetaSinOverAlpha,
+			PosVel: expSin / alpha,
+			VelPos: -expSin*alpha - omegaZeta*expOmegaZetaSinOverAlpha,
+			VelVel: expCos - expOmegaZetaSinOverAlpha,
+		}
+	}
+
+	// Critically damped.
+	exp := f.Exp(-omega * dt)
+	timeExp := dt * exp
+	timeExpFreq := timeExp * omega
+
+	return Coef{
+		PosPos: timeExpFreq + exp,
+		PosVel: timeExp,
+		VelPos: -omega * timeExpFreq,
+		VelVel: -timeExpFreq + exp,
+	}
+}
+
+// Advance pos and vel towards target.
+func (c Coef) Update(pos, vel *f.Float, target f.Float) {
+	x := *pos - target
+	*pos = x*c.PosPos + *vel*c.PosVel + target
+	*vel = x*c.VelPos + *vel*c.VelVel
+}
+
+// Advance pos and vel towards target.
+func (c Coef) UpdateVect(pos, vel *v.Vect, target v.Vect) {
+	x := v.Sub(*pos, target)
+	*pos = v.Add(v.Add(v.Mult(x, c.PosPos), v.Mult(*vel, c.PosVel)), target)
+	*vel = v.Add(v.Mult(x, c.VelPos), v.Mult(*vel, c.VelVel))
+}
+
+// Advance pos and vel towards target by dt.
+func (s Spring) Update(pos, vel *f.Float, target, dt f.Float) {
+	s.Coef(dt).Update(pos, vel, target)
+}
+
+// Advance pos and vel towards target by dt.
+func (s Spring) UpdateVect(pos, vel *v.Vect, target v.Vect, dt f.Float) {
+	s.Coef(dt).UpdateVect(pos, vel, target)
+}
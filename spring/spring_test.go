@@ -0,0 +1,52 @@
+package spring
+
+import (
+	"github.com/oniproject/math/f"
+	"github.com/oniproject/math/v"
+	. "github.com/smartystreets/goconvey/convey"
+	"math"
+	"testing"
+)
+
+func TestSpring(test *testing.T) {
+	Convey("Spring", test, func() {
+		Convey("Critically damped", func() {
+			s := New(4, 1)
+			var x, vel f.Float = 1, 0
+			s.Update(&x, &vel, 0, 0.5)
+			want := (1 + 4*0.5) * math.Exp(-4*0.5)
+			So(x, ShouldAlmostEqual, want, 1e-5)
+		})
+
+		Convey("Step independent", func() {
+			for _, damping := range []f.Float{0.2, 1, 3} {
+				s := New(6, damping)
+				var a, av f.Float = 1, 2
+				var b, bv f.Float = 1, 2
+				c := s.Coef(0.01)
+				for i := 0; i < 100; i++ {
+					c.Update(&a, &av, 0)
+				}
+				s.Update(&b, &bv, 0, 1.0)
+				So(a, ShouldAlmostEqual, b, 1e-4)
+				So(av, ShouldAlmostEqual, bv, 1e-4)
+			}
+		})
+
+		Convey("Under-damped overshoots", func() {
+			s := New(10, 0.1)
+			var x, vel f.Float = 1, 0
+			s.Update(&x, &vel, 0, math.Pi/10)
+			So(x, ShouldBeLessThan, 0)
+		})
+
+		Convey("Vect", func() {
+			s := New(8, 1)
+			var p, vel v.Vect
+			for i := 0; i < 120; i++ {
+				s.UpdateVect(&p, &vel, v.V(3, 4), 1.0/60.0)
+			}
+			So(v.Dist(p, v.V(3, 4)), ShouldBeLessThan, 1e-2)
+		})
+	})
+}
@@ -0,0 +1,209 @@
+package t
+
+import "math"
+import "math/rand"
+
+import "github.com/oniproject/math/f"
+import "github.com/oniproject/math/v"
+
+// Function type shared by FitAffine, FitSimilarity and FitRigid.
+type FitFunc func(src, dst []v.Vect) (Transform, f.Float, bool)
+
+// Returns the root mean square distance between t applied to src and dst.
+func Residual(t Transform, src, dst []v.Vect) f.Float {
+	if len(src) == 0 {
+		return 0.0
+	}
+	var sum float64
+	for i := range src {
+		sum += float64(v.DistSq(t.Point(src[i]), dst[i]))
+	}
+	return f.Float(math.Sqrt(sum / float64(len(src))))
+}
+
+// Centroids and centered second moments of two point sets.
+type moments struct {
+	cx, cy, cu, cw float64
+	xx, xy, yy     float64
+	xu, xw, yu, yw float64
+}
+
+func computeMoments(src, dst []v.Vect) (m moments) {
+	n := float64(len(src))
+	for i := range src {
+		m.cx += float64(src[i].X)
+		m.cy += float64(src[i].Y)
+		m.cu += float64(dst[i].X)
+		m.cw += float64(dst[i].Y)
+	}
+	m.cx, m.cy, m.cu, m.cw = m.cx/n, m.cy/n, m.cu/n, m.cw/n
+
+	for i := range src {
+		x, y := float64(src[i].X)-m.cx, float64(src[i].Y)-m.cy
+		u, w := float64(dst[i].X)-m.cu, float64(dst[i].Y)-m.cw
+		m.xx += x * x
+		m.xy += x * y
+		m.yy += y * y
+		m.xu += x * u
+		m.xw += x * w
+		m.yu += y * u
+		m.yw += y * w
+	}
+	return
+}
+
+// Least squares fit of a full affine transform mapping src onto dst.
+// Returns the transform, the RMS residual and false if src is degenerate
+// (fewer than three points or all points are collinear).
+func FitAffine(src, dst []v.Vect) (Transform, f.Float, bool) {
+	if len(src) < 3 || len(src) != len(dst) {
+		return Identity(), f.Inf, false
+	}
+	m := computeMoments(src, dst)
+
+	det := m.xx*m.yy - m.xy*m.xy
+	if math.Abs(det) <= 1e-12*(m.xx*m.yy+1e-300) {
+		return Identity(), f.Inf, false
+	}
+	inv_det := 1.0 / det
+
+	a := (m.yy*m.xu - m.xy*m.yu) * inv_det
+	c := (m.xx*m.yu - m.xy*m.xu) * inv_det
+	b := (m.yy*m.xw - m.xy*m.yw) * inv_det
+	d := (m.xx*m.yw - m.xy*m.xw) * inv_det
+
+	t := New(
+		f.Float(a), f.Float(b), f.Float(c), f.Float(d),
+		f.Float(m.cu-a*m.cx-c*m.cy),
+		f.Float(m.cw-b*m.cx-d*m.cy),
+	)
+	return t, Residual(t, src, dst), true
+}
+
+// Builds a rotation+uniform scale+translation from the centered moments.
+func similarity(m moments, s, cos, sin float64) Transform {
+	a, b := s*cos, s*sin
+	return Transpose(
+		f.Float(a), f.Float(-b), f.Float(m.cu-(a*m.cx-b*m.cy)),
+		f.Float(b), f.Float(+a), f.Float(m.cw-(b*m.cx+a*m.cy)),
+	)
+}
+
+// Least squares fit of a similarity transform (uniform scale + rotation + translation)
+// mapping src onto dst. (Umeyama's method)
+// Returns the transform, the RMS residual and false if src is degenerate.
+func FitSimilarity(src, dst []v.Vect) (Transform, f.Float, bool) {
+	if len(src) < 2 || len(src) != len(dst) {
+		return Identity(), f.Inf, false
+	}
+	m := computeMoments(src, dst)
+
+	variance := m.xx + m.yy
+	if variance <= 0.0 {
+		return Identity(), f.Inf, false
+	}
+	a := m.xu + m.yw
+	b := m.xw - m.yu
+	l := math.Hypot(a, b)
+	if l == 0.0 {
+		return Identity(), f.Inf, false
+	}
+
+	t := similarity(m, l/variance, a/l, b/l)
+	return t, Residual(t, src, dst), true
+}
+
+// Least squares fit of a rigid transform (rotation + translation) mapping src onto dst.
+// (Procrustes/Kabsch in 2D)
+// Returns the transform, the RMS residual and false if src is degenerate.
+func FitRigid(src, dst []v.Vect) (Transform, f.Float, bool) {
+	if len(src) < 1 || len(src) != len(dst) {
+		return Identity(), f.Inf, false
+	}
+	m := computeMoments(src, dst)
+
+	a := m.xu + m.yw
+	b := m.xw - m.yu
+	l := math.Hypot(a, b)
+	cos, sin := 1.0, 0.0
+	if l != 0.0 {
+		cos, sin = a/l, b/l
+	}
+
+	t := similarity(m, 1.0, cos, sin)
+	return t, Residual(t, src, dst), true
+}
+
+// Robust fit using random sample consensus.
+// Each of the iterations fits a random sample of n correspondences,
+// points within threshold distance of their target are counted as inliers
+// and the best hypothesis is refitted to all of its inliers.
+// Use n = 3 for FitAffine and n = 2 for FitSimilarity or FitRigid.
+// Results are deterministic for a given rng state,
+// a nil rng uses a new source with seed 1 on every call.
+// Returns the transform, an inlier mask, the RMS residual over the inliers
+// and false if no hypothesis has at least n inliers.
+func FitRANSAC(src, dst []v.Vect, fit FitFunc, n, iterations int, threshold f.Float, rng *rand.Rand) (Transform, []bool, f.Float, bool) {
+	if len(src) < n || len(src) != len(dst) || n <= 0 {
+		return Identity(), nil, f.Inf, false
+	}
+	if rng == nil {
+		rng = rand.New(rand.NewSource(1))
+	}
+
+	index := make([]int, len(src))
+	for i := range index {
+		index[i] = i
+	}
+	sampleSrc := make([]v.Vect, n)
+	sampleDst := make([]v.Vect, n)
+
+	best, bestCount := Identity(), 0
+	bestErr := f.Inf
+	thresholdSq := threshold * threshold
+
+	for iter := 0; iter < iterations; iter++ {
+		// Partial Fisher-Yates shuffle.
+		for i := 0; i < n; i++ {
+			j := i + rng.Intn(len(index)-i)
+			index[i], index[j] = index[j], index[i]
+			sampleSrc[i], sampleDst[i] = src[index[i]], dst[index[i]]
+		}
+
+		t, _, ok := fit(sampleSrc, sampleDst)
+		if !ok {
+			continue
+		}
+
+		count := 0
+		var err f.Float
+		for i := range src {
+			if d := v.DistSq(t.Point(src[i]), dst[i]); d <= thresholdSq {
+				count++
+				err += d
+			}
+		}
+		if count > bestCount || (count == bestCount && err < bestErr) {
+			best, bestCount, bestErr = t, count, err
+		}
+	}
+
+	if bestCount < n {
+		return Identity(), nil, f.Inf, false
+	}
+
+	inliers := make([]bool, len(src))
+	var inSrc, inDst []v.Vect
+	for i := range src {
+		if v.DistSq(best.Point(src[i]), dst[i]) <= thresholdSq {
+			inliers[i] = true
+			inSrc = append(inSrc, src[i])
+			inDst = append(inDst, dst[i])
+		}
+	}
+
+	if t, res, ok := fit(inSrc, inDst); ok {
+		return t, inliers, res, true
+	}
+	return best, inliers, Residual(best, inSrc, inDst), true
+}
@@ -0,0 +1,95 @@
+package t
+
+import (
+	"github.com/oniproject/math/f"
+	"github.com/oniproject/math/v"
+	. "github.com/smartystreets/goconvey/convey"
+	"math/rand"
+	"testing"
+)
+
+func TestFit(test *testing.T) {
+	Convey("Fit", test, func() {
+		src := []v.Vect{v.V(0, 0), v.V(4, 0), v.V(4, 2), v.V(0, 2), v.V(1, 1), v.V(3, -1)}
+		apply := func(t Transform) []v.Vect {
+			dst := make([]v.Vect, len(src))
+			for i := range src {
+				dst[i] = t.Point(src[i])
+			}
+			return dst
+		}
+		shouldMatch := func(t, want Transform) {
+			So(t.A, ShouldAlmostEqual, want.A, 1e-4)
+			So(t.B, ShouldAlmostEqual, want.B, 1e-4)
+			So(t.C, ShouldAlmostEqual, want.C, 1e-4)
+			So(t.D, ShouldAlmostEqual, want.D, 1e-4)
+			So(t.Tx, ShouldAlmostEqual, want.Tx, 1e-4)
+			So(t.Ty, ShouldAlmostEqual, want.Ty, 1e-4)
+		}
+
+		Convey("Affine", func() {
+			want := New(2, 0.5, -1, 3, 7, -2)
+			t, res, ok := FitAffine(src, apply(want))
+			So(ok, ShouldBeTrue)
+			So(res, ShouldAlmostEqual, 0, 1e-4)
+			shouldMatch(t, want)
+
+			_, _, ok = FitAffine([]v.Vect{v.V(0, 0), v.V(1, 1), v.V(2, 2)}, src[:3])
+			So(ok, ShouldBeFalse)
+		})
+
+		Convey("Similarity", func() {
+			want := Mult(Translate(v.V(3, -1)), Mult(Rotate(0.7), Scale(2, 2)))
+			t, res, ok := FitSimilarity(src, apply(want))
+			So(ok, ShouldBeTrue)
+			So(res, ShouldAlmostEqual, 0, 1e-4)
+			shouldMatch(t, want)
+		})
+
+		Convey("Rigid", func() {
+			want := Rigid(v.V(-5, 2), 2.5)
+			t, res, ok := FitRigid(src, apply(want))
+			So(ok, ShouldBeTrue)
+			So(res, ShouldAlmostEqual, 0, 1e-4)
+			shouldMatch(t, want)
+
+			// Scale is ignored by the rigid fit.
+			_, res, _ = FitRigid(src, apply(Scale(2, 2)))
+			So(res, ShouldBeGreaterThan, 0.5)
+		})
+
+		Convey("RANSAC", func() {
+			want := Rigid(v.V(1, 2), 0.3)
+			dst := apply(want)
+			dst[1] = v.V(100, 100)
+			dst[4] = v.V(-50, 20)
+
+			rng := rand.New(rand.NewSource(1))
+			t, inliers, res, ok := FitRANSAC(src, dst, FitRigid, 2, 50, 0.01, rng)
+			So(ok, ShouldBeTrue)
+			So(res, ShouldAlmostEqual, 0, 1e-4)
+			So(inliers, ShouldResemble, []bool{true, false, true, true, false, true})
+			shouldMatch(t, want)
+
+			rng = rand.New(rand.NewSource(1))
+			t2, _, _, _ := FitRANSAC(src, dst, FitRigid, 2, 50, 0.01, rng)
+			So(t2, ShouldResemble, t)
+
+			// A nil rng is the same as a new source with seed 1.
+			t3, inliers3, _, ok := FitRANSAC(src, dst, FitRigid, 2, 50, 0.01, nil)
+			So(ok, ShouldBeTrue)
+			So(t3, ShouldResemble, t)
+			So(inliers3, ShouldResemble, inliers)
+
+			// Nothing within the threshold is not a fit.
+			far := make([]v.Vect, len(src))
+			for i, p := range src {
+				far[i] = v.V(p.X*f.Float(i*i)*100, -p.Y*1e3+f.Float(i)*1e4)
+			}
+			_, inliers, res, ok = FitRANSAC(src, far, FitRigid, 2, 50, 0.01, nil)
+			So(ok, ShouldBeFalse)
+			So(inliers, ShouldBeNil)
+			So(res, ShouldEqual, f.Inf)
+		})
+	})
+}
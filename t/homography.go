@@ -0,0 +1,158 @@
+package t
+
+import "github.com/oniproject/math/f"
+import "github.com/oniproject/math/v"
+
+// Projective 3x3 transform matrix.
+//
+//	| A C Tx |
+//	| B D Ty |
+//	| P Q W  |
+//
+// The upper two rows have the same meaning as in Transform,
+// (P, Q, W) is the projective row.
+type Homography struct {
+	A, B, C, D, Tx, Ty, P, Q, W f.Float
+}
+
+// Identity homography matrix.
+func HomographyIdentity() Homography {
+	return Homography{1.0, 0.0, 0.0, 1.0, 0.0, 0.0, 0.0, 0.0, 1.0}
+}
+
+// Construct a homography from an affine transform matrix.
+func HomographyFromTransform(t Transform) Homography {
+	return Homography{t.A, t.B, t.C, t.D, t.Tx, t.Ty, 0.0, 0.0, 1.0}
+}
+
+// Construct the homography mapping the four points in src to the four points in dst.
+// Uses the direct linear transform with W fixed to 1.
+// Returns false if the points are degenerate (three of them are collinear).
+func HomographyFromPoints(src, dst [4]v.Vect) (Homography, bool) {
+	// Unknowns are A, C, Tx, B, D, Ty, P, Q.
+	var m [8][9]float64
+	for i := 0; i < 4; i++ {
+		x, y := float64(src[i].X), float64(src[i].Y)
+		u, w := float64(dst[i].X), float64(dst[i].Y)
+		m[2*i+0] = [9]float64{x, y, 1, 0, 0, 0, -u * x, -u * y, u}
+		m[2*i+1] = [9]float64{0, 0, 0, x, y, 1, -w * x, -w * y, w}
+	}
+
+	// Gaussian elimination with partial pivoting.
+	for col := 0; col < 8; col++ {
+		pivot := col
+		for row := col + 1; row < 8; row++ {
+			if abs64(m[row][col]) > abs64(m[pivot][col]) {
+				pivot = row
+			}
+		}
+		if abs64(m[pivot][col]) < 1e-12 {
+			return Homography{}, false
+		}
+		m[col], m[pivot] = m[pivot], m[col]
+
+		for row := 0; row < 8; row++ {
+			if row == col {
+				continue
+			}
+			k := m[row][col] / m[col][col]
+			for i := col; i < 9; i++ {
+				m[row][i] -= k * m[col][i]
+			}
+		}
+	}
+
+	var h [8]f.Float
+	for i := range h {
+		h[i] = f.Float(m[i][8] / m[i][i])
+	}
+	return Homography{
+		A: h[0], C: h[1], Tx: h[2],
+		B: h[3], D: h[4], Ty: h[5],
+		P: h[6], Q: h[7], W: 1.0,
+	}, true
+}
+
+func abs64(x float64) float64 {
+	if x < 0 {
+		return -x
+	}
+	return x
+}
+
+// Transform an absolute point with the perspective divide.
+func (h Homography) Point(p v.Vect) v.Vect {
+	w := 1.0 / (h.P*p.X + h.Q*p.Y + h.W)
+	return v.Vect{
+		X: (h.A*p.X + h.C*p.Y + h.Tx) * w,
+		Y: (h.B*p.X + h.D*p.Y + h.Ty) * w,
+	}
+}
+
+// Transform an absolute point by the inverse of the homography.
+// h must be invertible, see Inverse.
+func (h Homography) PointInverse(p v.Vect) v.Vect {
+	return h.Inverse().Point(p)
+}
+
+// Multiply two homography matrices.
+func (h Homography) Mult(o Homography) Homography {
+	return Homography{
+		A:  h.A*o.A + h.C*o.B + h.Tx*o.P,
+		C:  h.A*o.C + h.C*o.D + h.Tx*o.Q,
+		Tx: h.A*o.Tx + h.C*o.Ty + h.Tx*o.W,
+
+		B:  h.B*o.A + h.D*o.B + h.Ty*o.P,
+		D:  h.B*o.C + h.D*o.D + h.Ty*o.Q,
+		Ty: h.B*o.Tx + h.D*o.Ty + h.Ty*o.W,
+
+		P: h.P*o.A + h.Q*o.B + h.W*o.P,
+		Q: h.P*o.C + h.Q*o.D + h.W*o.Q,
+		W: h.P*o.Tx + h.Q*o.Ty + h.W*o.W,
+	}
+}
+
+// Returns the determinant of the homography matrix.
+func (h Homography) Det() f.Float {
+	return h.A*(h.D*h.W-h.Ty*h.Q) -
+		h.C*(h.B*h.W-h.Ty*h.P) +
+		h.Tx*(h.B*h.Q-h.D*h.P)
+}
+
+// Get the inverse of a homography matrix.
+// Like t.Inverse, h must be invertible: check Det() != 0 first,
+// a singular homography gives Inf or NaN entries.
+func (h Homography) Inverse() Homography {
+	inv_det := 1.0 / h.Det()
+	return Homography{
+		A:  (h.D*h.W - h.Ty*h.Q) * inv_det,
+		C:  (h.Tx*h.Q - h.C*h.W) * inv_det,
+		Tx: (h.C*h.Ty - h.Tx*h.D) * inv_det,
+
+		B:  (h.Ty*h.P - h.B*h.W) * inv_det,
+		D:  (h.A*h.W - h.Tx*h.P) * inv_det,
+		Ty: (h.Tx*h.B - h.A*h.Ty) * inv_det,
+
+		P: (h.B*h.Q - h.D*h.P) * inv_det,
+		Q: (h.C*h.P - h.A*h.Q) * inv_det,
+		W: (h.A*h.D - h.C*h.B) * inv_det,
+	}
+}
+
+// Returns a copy of h scaled so that W is 1.
+func (h Homography) Normalize() Homography {
+	s := 1.0 / h.W
+	return Homography{
+		h.A * s, h.B * s, h.C * s, h.D * s,
+		h.Tx * s, h.Ty * s, h.P * s, h.Q * s, 1.0,
+	}
+}
+
+// Multiply two homography matrices, same as h1.Mult(h2).
+func HomographyMult(h1, h2 Homography) Homography { return h1.Mult(h2) }
+
+// Get the inverse of an invertible homography matrix, same as h.Inverse().
+func HomographyInverse(h Homography) Homography { return h.Inverse() }
+
+// Returns a copy of h scaled so that W is 1, same as h.Normalize().
+func HomographyNormalize(h Homography) Homography { return h.Normalize() }
@@ -0,0 +1,66 @@
+package t
+
+import (
+	"github.com/oniproject/math/v"
+	. "github.com/smartystreets/goconvey/convey"
+	"testing"
+)
+
+func TestHomography(test *testing.T) {
+	Convey("Homography", test, func() {
+		src := [4]v.Vect{v.V(0, 0), v.V(1, 0), v.V(1, 1), v.V(0, 1)}
+		dst := [4]v.Vect{v.V(0, 0), v.V(4, 1), v.V(3, 5), v.V(-1, 3)}
+
+		Convey("FromPoints", func() {
+			h, ok := HomographyFromPoints(src, dst)
+			So(ok, ShouldBeTrue)
+			for i := range src {
+				p := h.Point(src[i])
+				So(p.X, ShouldAlmostEqual, dst[i].X, 1e-4)
+				So(p.Y, ShouldAlmostEqual, dst[i].Y, 1e-4)
+			}
+
+			_, ok = HomographyFromPoints(
+				[4]v.Vect{v.V(0, 0), v.V(1, 1), v.V(2, 2), v.V(0, 1)}, dst)
+			So(ok, ShouldBeFalse)
+		})
+
+		Convey("Inverse", func() {
+			h, _ := HomographyFromPoints(src, dst)
+			hi := h.Inverse()
+			for i := range dst {
+				p := hi.Point(dst[i])
+				So(p.X, ShouldAlmostEqual, src[i].X, 1e-4)
+				So(p.Y, ShouldAlmostEqual, src[i].Y, 1e-4)
+
+				q := h.PointInverse(dst[i])
+				So(q.X, ShouldAlmostEqual, src[i].X, 1e-4)
+				So(q.Y, ShouldAlmostEqual, src[i].Y, 1e-4)
+			}
+
+			id := h.Mult(hi).Normalize()
+			So(id.A, ShouldAlmostEqual, 1, 1e-4)
+			So(id.D, ShouldAlmostEqual, 1, 1e-4)
+			So(id.P, ShouldAlmostEqual, 0, 1e-4)
+			So(id.Tx, ShouldAlmostEqual, 0, 1e-4)
+
+			So(HomographyInverse(h), ShouldResemble, hi)
+			So(HomographyNormalize(HomographyMult(h, hi)), ShouldResemble, id)
+			// Value receivers work on non-addressable values.
+			So(HomographyIdentity().Point(src[1]), ShouldResemble, src[1])
+
+			// A singular homography is detected by its determinant.
+			flat := Homography{1, 2, 2, 4, 0, 0, 0, 0, 1}
+			So(flat.Det(), ShouldEqual, 0)
+			So(HomographyIdentity().Det(), ShouldEqual, 1)
+		})
+
+		Convey("FromTransform", func() {
+			t := Mult(Translate(v.V(2, 3)), Rotate(0.5))
+			h := HomographyFromTransform(t)
+			p := v.V(-1, 8)
+			So(h.Point(p), ShouldResemble, t.Point(p))
+			So(HomographyFromTransform(Identity()), ShouldResemble, HomographyIdentity())
+		})
+	})
+}
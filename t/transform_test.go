@@ -8,7 +8,7 @@ import (
 
 func TestAABB(test *testing.T) {
 	Convey("Transform", test, func() {
-		t, s, r := Translate(v.Vect{2, 3}), Scale(4, 5), Rotate(0.5)
+		t, s, r := Translate(v.V(2, 3)), Scale(4, 5), Rotate(0.5)
 
 		Convey("Inverse", func() {
 			ti, si, ri := Inverse(t), Inverse(s), Inverse(r)
@@ -0,0 +1,151 @@
+package tween
+
+import "github.com/oniproject/math/f"
+
+// Plays animations one after another.
+// Time left over by a finished item is passed on to the next one.
+type Sequence struct {
+	Items []Animation
+	index int
+}
+
+// Convenience constructor for Sequence.
+func NewSequence(items ...Animation) *Sequence {
+	return &Sequence{Items: items}
+}
+
+// Advance the current item by dt.
+func (s *Sequence) Update(dt f.Float) f.Float {
+	for s.index < len(s.Items) {
+		dt = s.Items[s.index].Update(dt)
+		if !s.Items[s.index].Done() {
+			return 0.0
+		}
+		s.index++
+	}
+	return dt
+}
+
+// Returns true once every item has completed.
+func (s *Sequence) Done() bool { return s.index >= len(s.Items) }
+
+// Rewind the sequence and all of its items.
+func (s *Sequence) Reset() {
+	s.index = 0
+	for _, a := range s.Items {
+		a.Reset()
+	}
+}
+
+// Plays animations at the same time.
+type Parallel struct {
+	Items []Animation
+}
+
+// Convenience constructor for Parallel.
+func NewParallel(items ...Animation) *Parallel {
+	return &Parallel{Items: items}
+}
+
+// Advance all items by dt.
+func (p *Parallel) Update(dt f.Float) f.Float {
+	rest := dt
+	for _, a := range p.Items {
+		rest = f.Min(rest, a.Update(dt))
+	}
+	if !p.Done() {
+		return 0.0
+	}
+	return rest
+}
+
+// Returns true once every item has completed.
+func (p *Parallel) Done() bool {
+	for _, a := range p.Items {
+		if !a.Done() {
+			return false
+		}
+	}
+	return true
+}
+
+// Rewind all items.
+func (p *Parallel) Reset() {
+	for _, a := range p.Items {
+		a.Reset()
+	}
+}
+
+// Returns an animation that does nothing for d.
+func Wait(d f.Float) *Tween {
+	return New(d, func(f.Float) {})
+}
+
+// Returns an animation that calls fn once and completes immediately.
+func Call(fn func()) *Tween {
+	tw := New(0.0, func(f.Float) {})
+	tw.OnComplete = fn
+	return tw
+}
+
+// Drives a set of animations and drops them once they complete.
+type Player struct {
+	active   []Animation
+	updating bool
+}
+
+// Start playing a.
+func (p *Player) Add(a Animation) {
+	p.active = append(p.active, a)
+}
+
+// Stop playing a without completing it.
+func (p *Player) Remove(a Animation) {
+	for i, b := range p.active {
+		if a == b {
+			if p.updating {
+				// Update is ranging over active, it drops the hole when done.
+				p.active[i] = nil
+			} else {
+				p.active = append(p.active[:i], p.active[i+1:]...)
+			}
+			return
+		}
+	}
+}
+
+// Returns the number of animations still playing.
+func (p *Player) Len() int {
+	n := 0
+	for _, a := range p.active {
+		if a != nil {
+			n++
+		}
+	}
+	return n
+}
+
+// Advance all animations by dt in the order they were added.
+// Animations added by callbacks during Update start on the next call,
+// animations removed by callbacks are not advanced any more.
+func (p *Player) Update(dt f.Float) {
+	p.updating = true
+	// Index instead of range, Add may reallocate active from a callback.
+	for i, n := 0, len(p.active); i < n; i++ {
+		if a := p.active[i]; a != nil {
+			a.Update(dt)
+		}
+	}
+	p.updating = false
+
+	active := p.active[:0]
+	for _, a := range p.active {
+		if a != nil && !a.Done() {
+			active = append(active, a)
+		}
+	}
+	for i := len(active); i < len(p.active); i++ {
+		p.active[i] = nil
+	}
+	p.active = active
+}
@@ -0,0 +1,155 @@
+// Tweening of f.Float, v.Vect and t.Transform values.
+//
+// All animations are driven by Update(dt). The state of a tween depends only
+// on the sum of the time steps it was given, so replaying the same steps
+// produces identical values.
+package tween
+
+import "github.com/oniproject/math/ease"
+import "github.com/oniproject/math/f"
+import "github.com/oniproject/math/t"
+import "github.com/oniproject/math/v"
+
+// Anything that can be advanced in time.
+type Animation interface {
+	// Advance the animation by dt.
+	// Returns the part of dt left over after the animation completed, 0 while it is running.
+	Update(dt f.Float) f.Float
+	// Returns true once the animation has completed.
+	Done() bool
+	// Rewind the animation to its initial state.
+	Reset()
+}
+
+// Interpolates a value from 0 to 1 over Duration after waiting for Delay.
+type Tween struct {
+	Duration, Delay f.Float
+	// Number of extra cycles, -1 repeats forever.
+	Repeat int
+	// Play every other cycle backwards.
+	Yoyo bool
+	// Easing applied to the progress of every cycle, Linear if nil.
+	Ease ease.Func
+
+	OnStart    func()
+	OnUpdate   func()
+	OnRepeat   func()
+	OnComplete func()
+
+	apply func(p f.Float)
+	// Time since the start of cycle base, which is always even so that
+	// elapsed stays within one yoyo pair and keeps its precision.
+	elapsed f.Float
+	base    int
+	cycle   int
+	started bool
+	done    bool
+}
+
+// Create a tween calling apply with the eased progress.
+func New(duration f.Float, apply func(p f.Float)) *Tween {
+	return &Tween{Duration: duration, apply: apply}
+}
+
+// Create a tween of *target from one value to another.
+func Float(target *f.Float, from, to f.Float, duration f.Float) *Tween {
+	return New(duration, func(p f.Float) { *target = f.Lerp(from, to, p) })
+}
+
+// Create a tween of *target from one vector to another.
+func Vect(target *v.Vect, from, to v.Vect, duration f.Float) *Tween {
+	return New(duration, func(p f.Float) { *target = v.Lerp(from, to, p) })
+}
+
+// Create a tween of *target from one transform to another.
+// The matrices are interpolated component-wise.
+func Transform(target *t.Transform, from, to t.Transform, duration f.Float) *Tween {
+	return New(duration, func(p f.Float) {
+		*target = t.New(
+			f.Lerp(from.A, to.A, p), f.Lerp(from.B, to.B, p),
+			f.Lerp(from.C, to.C, p), f.Lerp(from.D, to.D, p),
+			f.Lerp(from.Tx, to.Tx, p), f.Lerp(from.Ty, to.Ty, p),
+		)
+	})
+}
+
+func call(fn func()) {
+	if fn != nil {
+		fn()
+	}
+}
+
+// Returns the eased value of progress p in the given cycle.
+func (tw *Tween) progress(p f.Float, cycle int) f.Float {
+	if tw.Yoyo && cycle%2 == 1 {
+		p = 1.0 - p
+	}
+	if tw.Ease != nil {
+		return tw.Ease(p)
+	}
+	return p
+}
+
+// Advance the tween by dt.
+func (tw *Tween) Update(dt f.Float) f.Float {
+	if tw.done {
+		return dt
+	}
+	tw.elapsed += dt
+
+	local := tw.elapsed - tw.Delay
+	if local < 0.0 {
+		return 0.0
+	}
+	if !tw.started {
+		tw.started = true
+		call(tw.OnStart)
+	}
+
+	if tw.Duration <= 0.0 {
+		tw.finish(tw.Repeat)
+		return f.Min(local, dt)
+	}
+
+	cycle := tw.base + int(local/tw.Duration)
+	if tw.Repeat >= 0 && cycle > tw.Repeat {
+		tw.finish(tw.Repeat)
+		return f.Min(local-tw.Duration*f.Float(tw.Repeat+1-tw.base), dt)
+	}
+
+	for ; tw.cycle < cycle; tw.cycle++ {
+		call(tw.OnRepeat)
+	}
+	p := (local - f.Float(cycle-tw.base)*tw.Duration) / tw.Duration
+	tw.apply(tw.progress(p, cycle))
+
+	// Drop whole yoyo pairs of consumed cycles from elapsed.
+	if n := (cycle - tw.base) &^ 1; n > 0 {
+		tw.elapsed -= f.Float(n) * tw.Duration
+		tw.base += n
+	}
+	call(tw.OnUpdate)
+	return 0.0
+}
+
+func (tw *Tween) finish(last int) {
+	for ; tw.cycle < last; tw.cycle++ {
+		call(tw.OnRepeat)
+	}
+	tw.apply(tw.progress(1.0, last))
+	tw.done = true
+	call(tw.OnUpdate)
+	call(tw.OnComplete)
+}
+
+// Returns true once the tween has completed.
+func (tw *Tween) Done() bool { return tw.done }
+
+// Rewind the tween. The target keeps its current value until the next Update.
+func (tw *Tween) Reset() {
+	tw.elapsed = 0.0
+	tw.base = 0
+	tw.cycle = 0
+	tw.started = false
+	tw.done = false
+}
@@ -0,0 +1,190 @@
+package tween
+
+import (
+	"github.com/oniproject/math/ease"
+	"github.com/oniproject/math/f"
+	"github.com/oniproject/math/t"
+	"github.com/oniproject/math/v"
+	. "github.com/smartystreets/goconvey/convey"
+	"testing"
+)
+
+func TestTween(test *testing.T) {
+	Convey("Tween", test, func() {
+		Convey("Float", func() {
+			var x f.Float
+			tw := Float(&x, 0, 10, 2)
+			So(tw.Update(0.5), ShouldEqual, 0)
+			So(x, ShouldEqual, 2.5)
+			So(tw.Update(1.0), ShouldEqual, 0)
+			So(x, ShouldEqual, 7.5)
+			So(tw.Done(), ShouldBeFalse)
+			So(tw.Update(1.0), ShouldEqual, 0.5)
+			So(x, ShouldEqual, 10)
+			So(tw.Done(), ShouldBeTrue)
+			So(tw.Update(1.0), ShouldEqual, 1.0)
+		})
+
+		Convey("Vect and Transform", func() {
+			var p v.Vect
+			Vect(&p, v.V(0, 0), v.V(4, 8), 1).Update(0.25)
+			So(p, ShouldResemble, v.V(1, 2))
+
+			var m t.Transform
+			Transform(&m, t.Identity(), t.Translate(v.V(4, 0)), 1).Update(0.5)
+			So(m, ShouldResemble, t.Translate(v.V(2, 0)))
+		})
+
+		Convey("Delay, Ease and callbacks", func() {
+			var x f.Float
+			var log []string
+			tw := Float(&x, 0, 1, 1)
+			tw.Delay = 1
+			tw.Ease = ease.InQuad
+			tw.OnStart = func() { log = append(log, "start") }
+			tw.OnComplete = func() { log = append(log, "complete") }
+
+			tw.Update(0.5)
+			So(x, ShouldEqual, 0)
+			So(log, ShouldBeEmpty)
+			tw.Update(1.0)
+			So(x, ShouldEqual, 0.25)
+			So(log, ShouldResemble, []string{"start"})
+			tw.Update(1.0)
+			So(x, ShouldEqual, 1)
+			So(log, ShouldResemble, []string{"start", "complete"})
+		})
+
+		Convey("Repeat and Yoyo", func() {
+			var x f.Float
+			repeats := 0
+			tw := Float(&x, 0, 4, 1)
+			tw.Repeat = 2
+			tw.Yoyo = true
+			tw.OnRepeat = func() { repeats++ }
+
+			tw.Update(0.25)
+			So(x, ShouldEqual, 1)
+			tw.Update(1.0)
+			So(x, ShouldEqual, 3)
+			So(repeats, ShouldEqual, 1)
+			tw.Update(1.0)
+			So(x, ShouldEqual, 1)
+			So(tw.Update(1.0), ShouldEqual, 0.25)
+			So(x, ShouldEqual, 4)
+			So(repeats, ShouldEqual, 2)
+
+			tw.Reset()
+			tw.Repeat = -1
+			So(tw.Update(100.5), ShouldEqual, 0)
+			So(tw.Done(), ShouldBeFalse)
+		})
+
+		Convey("Repeat forever keeps its precision", func() {
+			var x f.Float
+			tw := Float(&x, 0, 1, 1)
+			tw.Repeat = -1
+			// An hour at 60 Hz, then every frame still moves by 1/60.
+			for i := 0; i < 60*60*60; i++ {
+				tw.Update(1.0 / 60.0)
+			}
+			for i := 0; i < 90; i++ {
+				prev := x
+				tw.Update(1.0 / 60.0)
+				if x > prev {
+					So(x-prev, ShouldAlmostEqual, 1.0/60.0, 1e-5)
+				}
+			}
+			So(tw.Done(), ShouldBeFalse)
+		})
+
+		Convey("Sequence", func() {
+			var x, y f.Float
+			called := false
+			s := NewSequence(
+				Float(&x, 0, 1, 1),
+				Call(func() { called = true }),
+				Wait(1),
+				Float(&y, 0, 1, 1),
+			)
+			s.Update(1.5)
+			So(x, ShouldEqual, 1)
+			So(called, ShouldBeTrue)
+			So(y, ShouldEqual, 0)
+			s.Update(1.0)
+			So(y, ShouldEqual, 0.5)
+			So(s.Update(1.0), ShouldEqual, 0.5)
+			So(s.Done(), ShouldBeTrue)
+
+			s.Reset()
+			So(s.Done(), ShouldBeFalse)
+		})
+
+		Convey("Parallel", func() {
+			var x, y f.Float
+			p := NewParallel(Float(&x, 0, 1, 1), Float(&y, 0, 1, 2))
+			So(p.Update(1.0), ShouldEqual, 0)
+			So(x, ShouldEqual, 1)
+			So(y, ShouldEqual, 0.5)
+			So(p.Update(1.5), ShouldEqual, 0.5)
+			So(p.Done(), ShouldBeTrue)
+		})
+
+		Convey("Player", func() {
+			var x, y f.Float
+			var pl Player
+			pl.Add(Float(&x, 0, 1, 1))
+			pl.Add(Float(&y, 0, 1, 2))
+			pl.Update(1.0)
+			So(pl.Len(), ShouldEqual, 1)
+			pl.Update(1.0)
+			So(pl.Len(), ShouldEqual, 0)
+			So(x, ShouldEqual, 1)
+			So(y, ShouldEqual, 1)
+		})
+
+		Convey("Player Remove from a callback", func() {
+			var pl Player
+			var steps [4]int
+			anims := make([]*Tween, len(steps))
+			for i := range anims {
+				i := i
+				anims[i] = New(10, func(f.Float) { steps[i]++ })
+			}
+			// The first one removes itself, the second one removes the last.
+			anims[0].Duration = 0.5
+			anims[0].OnComplete = func() { pl.Remove(anims[0]) }
+			anims[1].OnRepeat = func() { pl.Remove(anims[3]) }
+			anims[1].Duration, anims[1].Repeat = 0.5, 1
+			for _, a := range anims {
+				pl.Add(a)
+			}
+
+			pl.Update(1.0)
+			So(steps[1], ShouldEqual, 1)
+			So(steps[2], ShouldEqual, 1)
+			So(steps[3], ShouldEqual, 0)
+			So(pl.Len(), ShouldEqual, 1)
+
+			pl.Update(1.0)
+			So(steps[0], ShouldEqual, 1)
+			So(steps[2], ShouldEqual, 2)
+			So(pl.Len(), ShouldEqual, 1)
+		})
+
+		Convey("Deterministic", func() {
+			run := func() []f.Float {
+				var x f.Float
+				tw := Float(&x, 0, 1, 0.7)
+				tw.Repeat, tw.Yoyo, tw.Ease = 3, true, ease.InOutSine
+				var out []f.Float
+				for i := 0; i < 200; i++ {
+					tw.Update(1.0 / 60.0)
+					out = append(out, x)
+				}
+				return out
+			}
+			So(run(), ShouldResemble, run())
+		})
+	})
+}
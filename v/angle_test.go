@@ -0,0 +1,18 @@
+package v
+
+import (
+	"github.com/oniproject/math/f"
+	. "github.com/smartystreets/goconvey/convey"
+	"testing"
+)
+
+func TestAngle(test *testing.T) {
+	Convey("Angles", test, func() {
+		a := f.Degrees(90)
+		So(FromAngle(a), ShouldResemble, ForAngle(f.Float(a)))
+		s, c := a.Sincos()
+		So(FromAngle(a), ShouldResemble, V(c, s))
+		So(AngleOf(V(0, 2)), ShouldEqual, a)
+		So(AngleOf(V(-1, 0)).Degrees(), ShouldAlmostEqual, 180, 1e-4)
+	})
+}
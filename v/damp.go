@@ -0,0 +1,15 @@
+package v
+
+import "github.com/oniproject/math/f"
+import gv "github.com/oniproject/math/generic/v"
+
+// Gradually move current towards target like a critically damped spring.
+// velocity holds the current velocity and is updated by every call.
+// smoothTime is roughly the time to reach the target, the speed is limited to maxSpeed.
+func SmoothDamp(current, target Vect, velocity *Vect, smoothTime, maxSpeed, dt f.Float) Vect {
+	return gv.SmoothDamp(current, target, velocity, smoothTime, maxSpeed, dt)
+}
+
+// Frame rate independent exponential decay of a towards b.
+// lambda is the decay rate, a larger lambda approaches b faster.
+func Damp(a, b Vect, lambda, dt f.Float) Vect { return gv.Damp(a, b, lambda, dt) }
@@ -0,0 +1,31 @@
+package v
+
+import (
+	"github.com/oniproject/math/f"
+	. "github.com/smartystreets/goconvey/convey"
+	"testing"
+)
+
+func TestDamp(test *testing.T) {
+	Convey("Damping", test, func() {
+		Convey("SmoothDamp", func() {
+			var p, vel Vect
+			target := V(3, 4)
+			for i := 0; i < 100; i++ {
+				last := Dist(p, target)
+				p = SmoothDamp(p, target, &vel, 0.3, f.Inf, 1.0/60.0)
+				So(Dist(p, target), ShouldBeLessThanOrEqualTo, last)
+			}
+			So(Dist(p, target), ShouldBeLessThan, 1e-2)
+
+			p, vel = Zero(), Zero()
+			SmoothDamp(p, target, &vel, 0.3, 2, 0.1)
+			So(vel.Length(), ShouldBeLessThanOrEqualTo, 2)
+		})
+		Convey("Damp", func() {
+			a := Damp(Damp(Zero(), V(10, 0), 5, 0.1), V(10, 0), 5, 0.1)
+			b := Damp(Zero(), V(10, 0), 5, 0.2)
+			So(a.X, ShouldAlmostEqual, b.X, 1e-5)
+		})
+	})
+}